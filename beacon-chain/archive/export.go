@@ -0,0 +1,118 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/stategenerator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "archive")
+
+// Export writes the canonical blocks of the DB between the given absolute slots, inclusive,
+// into an archive. The end slot is capped at the chain head. If withState is set, the parent
+// of the first block and its post-state are written as the anchor of the archive, so that it
+// can be imported into an empty DB. The genesis block has no parent, so it is its own anchor
+// along with the genesis state. It returns the number of exported blocks.
+func Export(ctx context.Context, w io.Writer, beaconDB *db.BeaconDB, startSlot uint64, endSlot uint64, withState bool) (int, error) {
+	head, err := beaconDB.ChainHead()
+	if err != nil {
+		return 0, fmt.Errorf("could not retrieve chain head: %v", err)
+	}
+	if endSlot > head.Slot {
+		endSlot = head.Slot
+	}
+	if startSlot > endSlot {
+		return 0, fmt.Errorf("start slot %d is after end slot %d",
+			startSlot-params.BeaconConfig().GenesisSlot,
+			endSlot-params.BeaconConfig().GenesisSlot,
+		)
+	}
+
+	var blocks []*pb.BeaconBlock
+	for slot := startSlot; slot <= endSlot; slot++ {
+		block, err := beaconDB.BlockBySlot(slot)
+		if err != nil {
+			return 0, fmt.Errorf("could not retrieve block at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		if block != nil {
+			blocks = append(blocks, block)
+		}
+	}
+	if len(blocks) == 0 {
+		return 0, errors.New("no canonical block in the slot range")
+	}
+
+	writer, err := NewWriter(w, Header{
+		StartSlot: startSlot,
+		EndSlot:   endSlot,
+		HasAnchor: withState,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if withState {
+		anchor, anchorState, err := anchorOf(ctx, beaconDB, blocks[0])
+		if err != nil {
+			return 0, err
+		}
+		if err := writer.WriteAnchor(anchor, anchorState); err != nil {
+			return 0, fmt.Errorf("could not write anchor: %v", err)
+		}
+		log.WithField("slot", anchor.Slot-params.BeaconConfig().GenesisSlot).Info("Exported anchor state")
+	}
+	for _, block := range blocks {
+		if err := writer.WriteBlock(block); err != nil {
+			return 0, fmt.Errorf("could not write block at slot %d: %v", block.Slot-params.BeaconConfig().GenesisSlot, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return 0, err
+	}
+	return len(blocks), nil
+}
+
+// anchorOf returns the parent of the given block along with its post-state, which is
+// regenerated if it was not stored in full. The anchor of the genesis block is the genesis
+// block itself.
+func anchorOf(ctx context.Context, beaconDB *db.BeaconDB, block *pb.BeaconBlock) (*pb.BeaconBlock, *pb.BeaconState, error) {
+	anchor := block
+	anchorRoot, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not tree hash block: %v", err)
+	}
+	if block.Slot != params.BeaconConfig().GenesisSlot {
+		anchorRoot = bytesutil.ToBytes32(block.ParentRootHash32)
+		anchor, err = beaconDB.Block(anchorRoot)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not retrieve anchor block: %v", err)
+		}
+		if anchor == nil {
+			return nil, nil, fmt.Errorf("anchor block %#x not found", anchorRoot)
+		}
+	}
+	anchorState, err := beaconDB.StateByRoot(anchorRoot)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not retrieve anchor state: %v", err)
+	}
+	if anchorState != nil {
+		return anchor, anchorState, nil
+	}
+	generator, err := stategenerator.NewStateGenerator(beaconDB, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	anchorState, err = generator.StateAtSlot(ctx, anchorRoot, anchor.Slot)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not regenerate anchor state: %v", err)
+	}
+	return anchor, anchorState, nil
+}
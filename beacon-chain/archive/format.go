@@ -0,0 +1,299 @@
+// Package archive defines a portable file format for canonical beacon chain blocks, used to
+// export a chain from a node DB and replay it into another one.
+//
+// An archive starts with a magic string and a format version, followed by records. Every
+// record is a kind byte, the big-endian uint32 length of its payload, the payload itself and
+// a CRC-32 (Castagnoli) checksum of the kind and payload. The first record is the header,
+// which is followed by the optional anchor, the blocks in ascending slot order and an end
+// record holding the number of blocks, so that a truncated archive is detected.
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// FormatVersion is the version of the archive format written by this package.
+const FormatVersion = 1
+
+// maxRecordLength bounds the payload of a record, so that a corrupted length does not
+// cause an unbounded allocation.
+const maxRecordLength = 1 << 28
+
+const (
+	headerRecord byte = iota + 1
+	anchorRecord
+	blockRecord
+	endRecord
+)
+
+var (
+	magic         = []byte("PRYSMARC")
+	checksumTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// Header describes the content of an archive. Slots are absolute slot numbers.
+type Header struct {
+	StartSlot uint64
+	EndSlot   uint64
+	HasAnchor bool
+}
+
+func (h *Header) marshal() []byte {
+	enc := make([]byte, 17)
+	binary.BigEndian.PutUint64(enc[:8], h.StartSlot)
+	binary.BigEndian.PutUint64(enc[8:16], h.EndSlot)
+	if h.HasAnchor {
+		enc[16] = 1
+	}
+	return enc
+}
+
+func (h *Header) unmarshal(enc []byte) error {
+	if len(enc) != 17 {
+		return fmt.Errorf("invalid header length %d", len(enc))
+	}
+	h.StartSlot = binary.BigEndian.Uint64(enc[:8])
+	h.EndSlot = binary.BigEndian.Uint64(enc[8:16])
+	h.HasAnchor = enc[16] == 1
+	return nil
+}
+
+// Writer writes the records of an archive. The anchor, if the header announces one, has
+// to be written before any block, and Close has to be called once every block is written.
+type Writer struct {
+	w           *bufio.Writer
+	header      Header
+	wroteAnchor bool
+	blocks      uint64
+}
+
+// NewWriter writes the archive preamble and the given header to w.
+func NewWriter(w io.Writer, header Header) (*Writer, error) {
+	aw := &Writer{
+		w:      bufio.NewWriter(w),
+		header: header,
+	}
+	version := make([]byte, 4)
+	binary.BigEndian.PutUint32(version, FormatVersion)
+	if _, err := aw.w.Write(append(append([]byte{}, magic...), version...)); err != nil {
+		return nil, fmt.Errorf("could not write archive preamble: %v", err)
+	}
+	if err := aw.writeRecord(headerRecord, header.marshal()); err != nil {
+		return nil, fmt.Errorf("could not write archive header: %v", err)
+	}
+	return aw, nil
+}
+
+// WriteAnchor writes the anchor block of the archive along with its post-state.
+func (aw *Writer) WriteAnchor(block *pb.BeaconBlock, beaconState *pb.BeaconState) error {
+	if !aw.header.HasAnchor {
+		return errors.New("archive header does not announce an anchor")
+	}
+	if aw.wroteAnchor || aw.blocks > 0 {
+		return errors.New("anchor has to be written once, before any block")
+	}
+	blockEnc, err := proto.Marshal(block)
+	if err != nil {
+		return fmt.Errorf("could not encode anchor block: %v", err)
+	}
+	stateEnc, err := proto.Marshal(beaconState)
+	if err != nil {
+		return fmt.Errorf("could not encode anchor state: %v", err)
+	}
+	payload := make([]byte, 4, 4+len(blockEnc)+len(stateEnc))
+	binary.BigEndian.PutUint32(payload, uint32(len(blockEnc)))
+	payload = append(append(payload, blockEnc...), stateEnc...)
+	if err := aw.writeRecord(anchorRecord, payload); err != nil {
+		return err
+	}
+	aw.wroteAnchor = true
+	return nil
+}
+
+// WriteBlock appends a block to the archive.
+func (aw *Writer) WriteBlock(block *pb.BeaconBlock) error {
+	if aw.header.HasAnchor && !aw.wroteAnchor {
+		return errors.New("anchor has to be written before any block")
+	}
+	enc, err := proto.Marshal(block)
+	if err != nil {
+		return fmt.Errorf("could not encode block: %v", err)
+	}
+	if err := aw.writeRecord(blockRecord, enc); err != nil {
+		return err
+	}
+	aw.blocks++
+	return nil
+}
+
+// Close writes the end record of the archive and flushes it. It does not close the
+// underlying writer.
+func (aw *Writer) Close() error {
+	if aw.header.HasAnchor && !aw.wroteAnchor {
+		return errors.New("archive header announces an anchor which was not written")
+	}
+	count := make([]byte, 8)
+	binary.BigEndian.PutUint64(count, aw.blocks)
+	if err := aw.writeRecord(endRecord, count); err != nil {
+		return err
+	}
+	return aw.w.Flush()
+}
+
+func (aw *Writer) writeRecord(kind byte, payload []byte) error {
+	if len(payload) > maxRecordLength {
+		return fmt.Errorf("record of %d bytes exceeds the maximum of %d bytes", len(payload), maxRecordLength)
+	}
+	prefix := make([]byte, 5)
+	prefix[0] = kind
+	binary.BigEndian.PutUint32(prefix[1:], uint32(len(payload)))
+	checksum := crc32.Update(crc32.Checksum([]byte{kind}, checksumTable), checksumTable, payload)
+	suffix := make([]byte, 4)
+	binary.BigEndian.PutUint32(suffix, checksum)
+	for _, part := range [][]byte{prefix, payload, suffix} {
+		if _, err := aw.w.Write(part); err != nil {
+			return fmt.Errorf("could not write record: %v", err)
+		}
+	}
+	return nil
+}
+
+// Reader reads the records of an archive, verifying their checksums.
+type Reader struct {
+	r           *bufio.Reader
+	header      Header
+	anchorBlock *pb.BeaconBlock
+	anchorState *pb.BeaconState
+	blocks      uint64
+	done        bool
+}
+
+// NewReader reads the archive preamble, header and anchor from r.
+func NewReader(r io.Reader) (*Reader, error) {
+	ar := &Reader{r: bufio.NewReader(r)}
+	preamble := make([]byte, len(magic)+4)
+	if _, err := io.ReadFull(ar.r, preamble); err != nil {
+		return nil, fmt.Errorf("could not read archive preamble: %v", err)
+	}
+	if !bytes.Equal(preamble[:len(magic)], magic) {
+		return nil, errors.New("not a beacon chain archive")
+	}
+	if version := binary.BigEndian.Uint32(preamble[len(magic):]); version != FormatVersion {
+		return nil, fmt.Errorf("unsupported archive format version %d, expected %d", version, FormatVersion)
+	}
+
+	kind, payload, err := ar.readRecord()
+	if err != nil {
+		return nil, err
+	}
+	if kind != headerRecord {
+		return nil, fmt.Errorf("expected header record, found record kind %d", kind)
+	}
+	if err := ar.header.unmarshal(payload); err != nil {
+		return nil, err
+	}
+	if !ar.header.HasAnchor {
+		return ar, nil
+	}
+
+	kind, payload, err = ar.readRecord()
+	if err != nil {
+		return nil, err
+	}
+	if kind != anchorRecord {
+		return nil, fmt.Errorf("expected anchor record, found record kind %d", kind)
+	}
+	if len(payload) < 4 || uint64(binary.BigEndian.Uint32(payload)) > uint64(len(payload)-4) {
+		return nil, errors.New("invalid anchor record")
+	}
+	blockLen := 4 + binary.BigEndian.Uint32(payload)
+	ar.anchorBlock = &pb.BeaconBlock{}
+	if err := proto.Unmarshal(payload[4:blockLen], ar.anchorBlock); err != nil {
+		return nil, fmt.Errorf("could not decode anchor block: %v", err)
+	}
+	ar.anchorState = &pb.BeaconState{}
+	if err := proto.Unmarshal(payload[blockLen:], ar.anchorState); err != nil {
+		return nil, fmt.Errorf("could not decode anchor state: %v", err)
+	}
+	return ar, nil
+}
+
+// Header returns the header of the archive.
+func (ar *Reader) Header() Header {
+	return ar.header
+}
+
+// Anchor returns the anchor block of the archive and its post-state, or nil if the archive
+// does not have an anchor.
+func (ar *Reader) Anchor() (*pb.BeaconBlock, *pb.BeaconState) {
+	return ar.anchorBlock, ar.anchorState
+}
+
+// NextBlock returns the next block of the archive. It returns io.EOF once the end record
+// was read and the number of blocks read matches it.
+func (ar *Reader) NextBlock() (*pb.BeaconBlock, error) {
+	if ar.done {
+		return nil, io.EOF
+	}
+	kind, payload, err := ar.readRecord()
+	if err != nil {
+		return nil, err
+	}
+	switch kind {
+	case blockRecord:
+		block := &pb.BeaconBlock{}
+		if err := proto.Unmarshal(payload, block); err != nil {
+			return nil, fmt.Errorf("could not decode block: %v", err)
+		}
+		ar.blocks++
+		return block, nil
+	case endRecord:
+		if len(payload) != 8 {
+			return nil, fmt.Errorf("invalid end record length %d", len(payload))
+		}
+		if count := binary.BigEndian.Uint64(payload); count != ar.blocks {
+			return nil, fmt.Errorf("archive holds %d blocks, expected %d", ar.blocks, count)
+		}
+		ar.done = true
+		return nil, io.EOF
+	default:
+		return nil, fmt.Errorf("unexpected record kind %d", kind)
+	}
+}
+
+func (ar *Reader) readRecord() (byte, []byte, error) {
+	prefix := make([]byte, 5)
+	if _, err := io.ReadFull(ar.r, prefix); err != nil {
+		return 0, nil, truncated(err)
+	}
+	length := binary.BigEndian.Uint32(prefix[1:])
+	if length > maxRecordLength {
+		return 0, nil, fmt.Errorf("record of %d bytes exceeds the maximum of %d bytes", length, maxRecordLength)
+	}
+	payload := make([]byte, length+4)
+	if _, err := io.ReadFull(ar.r, payload); err != nil {
+		return 0, nil, truncated(err)
+	}
+	payload, suffix := payload[:length], payload[length:]
+	checksum := crc32.Update(crc32.Checksum(prefix[:1], checksumTable), checksumTable, payload)
+	if checksum != binary.BigEndian.Uint32(suffix) {
+		return 0, nil, errors.New("archive record checksum mismatch, the archive is corrupted")
+	}
+	return prefix[0], payload, nil
+}
+
+func truncated(err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return errors.New("archive is truncated")
+	}
+	return fmt.Errorf("could not read record: %v", err)
+}
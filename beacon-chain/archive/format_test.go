@@ -0,0 +1,126 @@
+package archive
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func writeTestArchive(t *testing.T, anchor *pb.BeaconBlock, anchorState *pb.BeaconState, blocks []*pb.BeaconBlock) []byte {
+	buf := new(bytes.Buffer)
+	writer, err := NewWriter(buf, Header{
+		StartSlot: params.BeaconConfig().GenesisSlot,
+		EndSlot:   params.BeaconConfig().GenesisSlot + 10,
+		HasAnchor: anchor != nil,
+	})
+	if err != nil {
+		t.Fatalf("Could not create writer: %v", err)
+	}
+	if anchor != nil {
+		if err := writer.WriteAnchor(anchor, anchorState); err != nil {
+			t.Fatalf("Could not write anchor: %v", err)
+		}
+	}
+	for _, block := range blocks {
+		if err := writer.WriteBlock(block); err != nil {
+			t.Fatalf("Could not write block: %v", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Could not close writer: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func readBlocks(reader *Reader) ([]*pb.BeaconBlock, error) {
+	var blocks []*pb.BeaconBlock
+	for {
+		block, err := reader.NextBlock()
+		if err == io.EOF {
+			return blocks, nil
+		}
+		if err != nil {
+			return blocks, err
+		}
+		blocks = append(blocks, block)
+	}
+}
+
+func TestArchive_RoundTrip(t *testing.T) {
+	anchor := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot}
+	anchorState := &pb.BeaconState{Slot: params.BeaconConfig().GenesisSlot}
+	blocks := []*pb.BeaconBlock{
+		{Slot: params.BeaconConfig().GenesisSlot + 1, ParentRootHash32: []byte{'A'}},
+		{Slot: params.BeaconConfig().GenesisSlot + 3, ParentRootHash32: []byte{'B'}},
+	}
+	enc := writeTestArchive(t, anchor, anchorState, blocks)
+
+	reader, err := NewReader(bytes.NewReader(enc))
+	if err != nil {
+		t.Fatalf("Could not read archive: %v", err)
+	}
+	header := reader.Header()
+	if !header.HasAnchor || header.EndSlot != params.BeaconConfig().GenesisSlot+10 {
+		t.Errorf("Unexpected header %+v", header)
+	}
+	readAnchor, readState := reader.Anchor()
+	if !proto.Equal(readAnchor, anchor) || !proto.Equal(readState, anchorState) {
+		t.Errorf("Expected anchor %v with state %v, received %v with state %v", anchor, anchorState, readAnchor, readState)
+	}
+	readBlks, err := readBlocks(reader)
+	if err != nil {
+		t.Fatalf("Could not read blocks: %v", err)
+	}
+	if len(readBlks) != len(blocks) {
+		t.Fatalf("Expected %d blocks, received %d", len(blocks), len(readBlks))
+	}
+	for i := range blocks {
+		if !proto.Equal(readBlks[i], blocks[i]) {
+			t.Errorf("Expected block %v, received %v", blocks[i], readBlks[i])
+		}
+	}
+}
+
+func TestReader_DetectsCorruption(t *testing.T) {
+	blocks := []*pb.BeaconBlock{{Slot: params.BeaconConfig().GenesisSlot + 1, ParentRootHash32: []byte{'A'}}}
+	enc := writeTestArchive(t, nil, nil, blocks)
+	// Flip a byte of the block record, which follows the 12 byte preamble and the header.
+	enc[12+5+17+4+6] ^= 0xff
+
+	reader, err := NewReader(bytes.NewReader(enc))
+	if err != nil {
+		t.Fatalf("Could not read archive: %v", err)
+	}
+	if _, err := readBlocks(reader); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Errorf("Expected checksum mismatch, received %v", err)
+	}
+}
+
+func TestReader_DetectsTruncation(t *testing.T) {
+	blocks := []*pb.BeaconBlock{
+		{Slot: params.BeaconConfig().GenesisSlot + 1},
+		{Slot: params.BeaconConfig().GenesisSlot + 2},
+	}
+	enc := writeTestArchive(t, nil, nil, blocks)
+	// Drop the end record.
+	enc = enc[:len(enc)-(5+8+4)]
+
+	reader, err := NewReader(bytes.NewReader(enc))
+	if err != nil {
+		t.Fatalf("Could not read archive: %v", err)
+	}
+	if _, err := readBlocks(reader); err == nil || !strings.Contains(err.Error(), "truncated") {
+		t.Errorf("Expected truncated archive error, received %v", err)
+	}
+}
+
+func TestReader_RejectsUnknownFormat(t *testing.T) {
+	if _, err := NewReader(strings.NewReader("NOTANARCHIVE")); err == nil {
+		t.Error("Expected reading a non-archive to fail")
+	}
+}
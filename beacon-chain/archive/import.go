@@ -0,0 +1,107 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// BlockReceiver processes the blocks replayed from an archive, as done by the
+// blockchain service for blocks received from the network.
+type BlockReceiver interface {
+	ReceiveBlock(block *pb.BeaconBlock, beaconState *pb.BeaconState) (*pb.BeaconState, error)
+	ApplyForkChoiceRule(block *pb.BeaconBlock, computedState *pb.BeaconState) error
+}
+
+// Import replays the blocks of an archive through the given receiver on top of the chain
+// head of the DB. An empty DB is first initialized from the anchor of the archive. Blocks
+// already known to the DB are skipped, any other block has to extend the chain head. It
+// returns the number of imported blocks.
+func Import(ctx context.Context, r io.Reader, beaconDB *db.BeaconDB, chain BlockReceiver) (int, error) {
+	reader, err := NewReader(r)
+	if err != nil {
+		return 0, err
+	}
+
+	headState, err := beaconDB.State(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if headState == nil {
+		anchor, anchorState := reader.Anchor()
+		if anchor == nil {
+			return 0, errors.New("the DB holds no chain and the archive has no anchor state to start one from")
+		}
+		if err := beaconDB.InitializeStateFromAnchor(anchor, anchorState); err != nil {
+			return 0, fmt.Errorf("could not initialize DB from the archive anchor: %v", err)
+		}
+		log.WithField("slot", anchor.Slot-params.BeaconConfig().GenesisSlot).Info("Initialized DB from archive anchor")
+	}
+
+	imported := 0
+	for {
+		block, err := reader.NextBlock()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return imported, err
+		}
+		if err := importBlock(ctx, beaconDB, chain, block); err != nil {
+			return imported, fmt.Errorf("could not import block at slot %d: %v",
+				block.Slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		imported++
+	}
+	return imported, nil
+}
+
+// importBlock processes a block on top of the chain head, unless the block is already known.
+func importBlock(ctx context.Context, beaconDB *db.BeaconDB, chain BlockReceiver, block *pb.BeaconBlock) error {
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("could not tree hash block: %v", err)
+	}
+	if beaconDB.HasBlock(root) {
+		log.WithField("slot", block.Slot-params.BeaconConfig().GenesisSlot).Debug("Skipped known block")
+		return nil
+	}
+
+	head, err := beaconDB.ChainHead()
+	if err != nil {
+		return fmt.Errorf("could not retrieve chain head: %v", err)
+	}
+	headRoot, err := hashutil.HashBeaconBlock(head)
+	if err != nil {
+		return fmt.Errorf("could not tree hash chain head: %v", err)
+	}
+	if !bytes.Equal(block.ParentRootHash32, headRoot[:]) {
+		return fmt.Errorf("block does not extend the chain head at slot %d",
+			head.Slot-params.BeaconConfig().GenesisSlot)
+	}
+	headState, err := beaconDB.State(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+
+	computedState, err := chain.ReceiveBlock(block, headState)
+	if err != nil {
+		return err
+	}
+	if err := chain.ApplyForkChoiceRule(block, computedState); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"slot":      block.Slot - params.BeaconConfig().GenesisSlot,
+		"blockRoot": fmt.Sprintf("%#x", root),
+	}).Info("Imported block")
+	return nil
+}
@@ -0,0 +1,198 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// mockReceiver saves received blocks along with their post-state, which is the parent
+// state advanced to the block slot, and makes them the chain head.
+type mockReceiver struct {
+	beaconDB *db.BeaconDB
+	received int
+}
+
+func (m *mockReceiver) ReceiveBlock(block *pb.BeaconBlock, beaconState *pb.BeaconState) (*pb.BeaconState, error) {
+	m.received++
+	if err := m.beaconDB.SaveBlock(block); err != nil {
+		return nil, err
+	}
+	newState := proto.Clone(beaconState).(*pb.BeaconState)
+	newState.Slot = block.Slot
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := m.beaconDB.SaveStateForBlock(root, newState); err != nil {
+		return nil, err
+	}
+	return newState, nil
+}
+
+func (m *mockReceiver) ApplyForkChoiceRule(block *pb.BeaconBlock, computedState *pb.BeaconState) error {
+	return m.beaconDB.UpdateChainHead(block, computedState)
+}
+
+// setupChain initializes the DB from an anchor at the genesis slot and extends it with
+// blocks at the given slots after genesis.
+func setupChain(t *testing.T, beaconDB *db.BeaconDB, slots []uint64) []*pb.BeaconBlock {
+	beaconDB.SetStateStoragePolicy(db.StoreAllStates)
+	anchor := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot}
+	anchorState := &pb.BeaconState{Slot: anchor.Slot}
+	if err := beaconDB.InitializeStateFromAnchor(anchor, anchorState); err != nil {
+		t.Fatalf("Could not initialize DB: %v", err)
+	}
+	receiver := &mockReceiver{beaconDB: beaconDB}
+	parent := anchor
+	var blocks []*pb.BeaconBlock
+	for _, slot := range slots {
+		parentRoot, err := hashutil.HashBeaconBlock(parent)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block := &pb.BeaconBlock{
+			Slot:             params.BeaconConfig().GenesisSlot + slot,
+			ParentRootHash32: parentRoot[:],
+		}
+		if err := importBlock(context.Background(), beaconDB, receiver, block); err != nil {
+			t.Fatalf("Could not extend chain: %v", err)
+		}
+		blocks = append(blocks, block)
+		parent = block
+	}
+	return blocks
+}
+
+func TestExportImport_ReplaysChainIntoEmptyDB(t *testing.T) {
+	source := internal.SetupDB(t)
+	defer internal.TeardownDB(t, source)
+	blocks := setupChain(t, source, []uint64{1, 2, 4, 5})
+
+	buf := new(bytes.Buffer)
+	exported, err := Export(
+		context.Background(),
+		buf,
+		source,
+		params.BeaconConfig().GenesisSlot+2,
+		params.BeaconConfig().GenesisSlot+100,
+		true, /* with state */
+	)
+	if err != nil {
+		t.Fatalf("Could not export chain: %v", err)
+	}
+	if exported != 3 {
+		t.Errorf("Expected 3 exported blocks, received %d", exported)
+	}
+
+	target := internal.SetupDB(t)
+	defer internal.TeardownDB(t, target)
+	receiver := &mockReceiver{beaconDB: target}
+	imported, err := Import(context.Background(), bytes.NewReader(buf.Bytes()), target, receiver)
+	if err != nil {
+		t.Fatalf("Could not import chain: %v", err)
+	}
+	if imported != 3 || receiver.received != 3 {
+		t.Errorf("Expected 3 imported blocks, received %d", imported)
+	}
+	head, err := target.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(head, blocks[len(blocks)-1]) {
+		t.Errorf("Expected chain head %v, received %v", blocks[len(blocks)-1], head)
+	}
+
+	// Importing the archive again skips the known blocks.
+	receiver.received = 0
+	if _, err := Import(context.Background(), bytes.NewReader(buf.Bytes()), target, receiver); err != nil {
+		t.Fatalf("Could not import chain again: %v", err)
+	}
+	if receiver.received != 0 {
+		t.Errorf("Expected known blocks to be skipped, %d were processed", receiver.received)
+	}
+}
+
+func TestExportImport_AnchorsGenesisExportOnGenesisState(t *testing.T) {
+	source := internal.SetupDB(t)
+	defer internal.TeardownDB(t, source)
+	blocks := setupChain(t, source, []uint64{1, 2})
+
+	buf := new(bytes.Buffer)
+	exported, err := Export(
+		context.Background(),
+		buf,
+		source,
+		params.BeaconConfig().GenesisSlot,
+		params.BeaconConfig().GenesisSlot+100,
+		true, /* with state */
+	)
+	if err != nil {
+		t.Fatalf("Could not export chain from genesis: %v", err)
+	}
+	if exported != 3 {
+		t.Errorf("Expected 3 exported blocks, received %d", exported)
+	}
+
+	reader, err := NewReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	anchor, anchorState := reader.Anchor()
+	if anchor == nil || anchor.Slot != params.BeaconConfig().GenesisSlot {
+		t.Fatalf("Expected the genesis block as anchor, received %v", anchor)
+	}
+	if anchorState.Slot != params.BeaconConfig().GenesisSlot {
+		t.Errorf("Expected the genesis state as anchor state, received state at slot %d", anchorState.Slot)
+	}
+
+	// The genesis block is known once the DB is initialized from the anchor.
+	target := internal.SetupDB(t)
+	defer internal.TeardownDB(t, target)
+	receiver := &mockReceiver{beaconDB: target}
+	imported, err := Import(context.Background(), bytes.NewReader(buf.Bytes()), target, receiver)
+	if err != nil {
+		t.Fatalf("Could not import chain: %v", err)
+	}
+	if imported != 3 || receiver.received != 2 {
+		t.Errorf("Expected 2 of the 3 imported blocks to be processed, received %d of %d", receiver.received, imported)
+	}
+	head, err := target.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(head, blocks[len(blocks)-1]) {
+		t.Errorf("Expected chain head %v, received %v", blocks[len(blocks)-1], head)
+	}
+}
+
+func TestImport_EmptyDBRequiresAnchor(t *testing.T) {
+	source := internal.SetupDB(t)
+	defer internal.TeardownDB(t, source)
+	setupChain(t, source, []uint64{1, 2})
+
+	buf := new(bytes.Buffer)
+	if _, err := Export(
+		context.Background(),
+		buf,
+		source,
+		params.BeaconConfig().GenesisSlot,
+		params.BeaconConfig().GenesisSlot+2,
+		false, /* with state */
+	); err != nil {
+		t.Fatalf("Could not export chain: %v", err)
+	}
+
+	target := internal.SetupDB(t)
+	defer internal.TeardownDB(t, target)
+	if _, err := Import(context.Background(), buf, target, &mockReceiver{beaconDB: target}); err == nil {
+		t.Error("Expected importing an archive without anchor into an empty DB to fail")
+	}
+}
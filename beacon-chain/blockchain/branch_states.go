@@ -0,0 +1,74 @@
+package blockchain
+
+import (
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// maxBranchStates bounds the number of side-branch states kept in memory. An epoch worth of
+// states lets a competing branch grow without replaying it from the finalized state.
+const maxBranchStates = 64
+
+// branchStates is a bounded cache of the post-states of blocks which were processed on top
+// of a side branch, keyed by block root. Blocks building on a side branch start from the
+// cached state of their parent instead of replaying the branch from the finalized state.
+type branchStates struct {
+	lock    sync.Mutex
+	maxSize int
+	states  map[[32]byte]*pb.BeaconState
+}
+
+// newBranchStates creates a cache holding at most maxSize states.
+func newBranchStates(maxSize int) *branchStates {
+	return &branchStates{
+		maxSize: maxSize,
+		states:  make(map[[32]byte]*pb.BeaconState),
+	}
+}
+
+// add caches a copy of the post-state of the block with the given root. When the cache is
+// full, the state with the lowest slot is evicted, as it is the least likely to be built upon.
+func (b *branchStates) add(blockRoot [32]byte, beaconState *pb.BeaconState) {
+	if b.maxSize <= 0 {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if _, ok := b.states[blockRoot]; !ok && len(b.states) >= b.maxSize {
+		var oldest [32]byte
+		oldestSlot := ^uint64(0)
+		for root, cached := range b.states {
+			if cached.Slot < oldestSlot {
+				oldest, oldestSlot = root, cached.Slot
+			}
+		}
+		delete(b.states, oldest)
+	}
+	b.states[blockRoot] = proto.Clone(beaconState).(*pb.BeaconState)
+}
+
+// get returns a copy of the cached post-state of the block with the given root, or nil if
+// it is not cached. The copy can be advanced by state transitions without altering the cache.
+func (b *branchStates) get(blockRoot [32]byte) *pb.BeaconState {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	cached, ok := b.states[blockRoot]
+	if !ok {
+		return nil
+	}
+	return proto.Clone(cached).(*pb.BeaconState)
+}
+
+// expire drops every cached state with a slot before the given slot, as no block can be
+// processed on top of them once they are behind the finalized slot.
+func (b *branchStates) expire(slot uint64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for root, cached := range b.states {
+		if cached.Slot < slot {
+			delete(b.states, root)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package blockchain
+
+import (
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestBranchStates_GetReturnsCopy(t *testing.T) {
+	b := newBranchStates(10)
+	root := [32]byte{'a'}
+	b.add(root, &pb.BeaconState{Slot: 5})
+
+	cached := b.get(root)
+	if cached == nil || cached.Slot != 5 {
+		t.Fatalf("Expected cached state at slot 5, received %v", cached)
+	}
+	cached.Slot = 6
+	if b.get(root).Slot != 5 {
+		t.Error("Expected modifying a returned state to leave the cache untouched")
+	}
+	if b.get([32]byte{'b'}) != nil {
+		t.Error("Expected no state for an unknown root")
+	}
+}
+
+func TestBranchStates_EvictsLowestSlot(t *testing.T) {
+	b := newBranchStates(2)
+	b.add([32]byte{'a'}, &pb.BeaconState{Slot: 3})
+	b.add([32]byte{'b'}, &pb.BeaconState{Slot: 1})
+	b.add([32]byte{'c'}, &pb.BeaconState{Slot: 2})
+
+	if b.get([32]byte{'b'}) != nil {
+		t.Error("Expected the lowest slot state to be evicted")
+	}
+	if b.get([32]byte{'a'}) == nil || b.get([32]byte{'c'}) == nil {
+		t.Error("Expected the higher slot states to be kept")
+	}
+}
+
+func TestBranchStates_ExpireBySlot(t *testing.T) {
+	b := newBranchStates(10)
+	b.add([32]byte{'a'}, &pb.BeaconState{Slot: 1})
+	b.add([32]byte{'b'}, &pb.BeaconState{Slot: 5})
+	b.expire(3)
+
+	if b.get([32]byte{'a'}) != nil {
+		t.Error("Expected state before the expiry slot to be dropped")
+	}
+	if b.get([32]byte{'b'}) == nil {
+		t.Error("Expected state after the expiry slot to be kept")
+	}
+}
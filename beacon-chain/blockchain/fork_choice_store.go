@@ -0,0 +1,213 @@
+package blockchain
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// forkChoiceNode is a block in the fork-choice block tree. Its weight is the
+// total balance of the latest votes for the block and all of its descendants.
+type forkChoiceNode struct {
+	root     [32]byte
+	block    *pb.BeaconBlock
+	parent   *forkChoiceNode
+	children []*forkChoiceNode
+	weight   uint64
+}
+
+// forkChoiceVote is the latest vote of a validator in the fork-choice store. A vote
+// is only applied to the tree weights once its target block is part of the tree.
+type forkChoiceVote struct {
+	root    [32]byte
+	balance uint64
+	applied bool
+}
+
+// forkChoiceStore keeps the tree of blocks since the last finalized block in memory,
+// along with the latest vote of every validator. Vote changes are applied incrementally
+// by updating the weights of the ancestors of the old and new targets, so that the
+// LMD GHOST head can be found by walking down the heaviest children from the
+// justified block, without any DB lookups.
+type forkChoiceStore struct {
+	lock  sync.RWMutex
+	root  *forkChoiceNode
+	nodes map[[32]byte]*forkChoiceNode
+	votes map[uint64]*forkChoiceVote
+}
+
+// newForkChoiceStore creates a fork-choice store rooted at the given finalized block.
+func newForkChoiceStore(finalizedBlock *pb.BeaconBlock) (*forkChoiceStore, error) {
+	root, err := hashutil.HashBeaconBlock(finalizedBlock)
+	if err != nil {
+		return nil, fmt.Errorf("could not tree hash finalized block: %v", err)
+	}
+	node := &forkChoiceNode{root: root, block: finalizedBlock}
+	return &forkChoiceStore{
+		root:  node,
+		nodes: map[[32]byte]*forkChoiceNode{root: node},
+		votes: make(map[uint64]*forkChoiceVote),
+	}, nil
+}
+
+// rootBlock returns the block the store is rooted at.
+func (s *forkChoiceStore) rootBlock() *pb.BeaconBlock {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.root.block
+}
+
+// hasBlock returns true if the block with the given root is part of the tree.
+func (s *forkChoiceStore) hasBlock(root [32]byte) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.nodes[root]
+	return ok
+}
+
+// insertBlock adds a block to the tree. The parent of the block must already be part
+// of the tree. Inserting a block which already exists does nothing.
+func (s *forkChoiceStore) insertBlock(block *pb.BeaconBlock) error {
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("could not tree hash block: %v", err)
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.nodes[root]; ok {
+		return nil
+	}
+	parent, ok := s.nodes[bytesutil.ToBytes32(block.ParentRootHash32)]
+	if !ok {
+		return fmt.Errorf("parent %#x of block %#x is not in the fork-choice store", block.ParentRootHash32, root)
+	}
+	node := &forkChoiceNode{root: root, block: block, parent: parent}
+	parent.children = append(parent.children, node)
+	s.nodes[root] = node
+	return nil
+}
+
+// applyVotes records the latest votes of all validators and the balances they carry.
+// If a validator previously voted for a different target or with a different balance,
+// the weight of the old vote is removed from the ancestors of the old target before the
+// new weight is added to the ancestors of the new target. Validators which are no
+// longer included in the targets have their previous vote removed.
+func (s *forkChoiceStore) applyVotes(targets map[uint64][32]byte, balance func(uint64) uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for validatorIndex, vote := range s.votes {
+		if _, ok := targets[validatorIndex]; !ok {
+			if vote.applied {
+				s.decreaseWeight(vote.root, vote.balance)
+			}
+			delete(s.votes, validatorIndex)
+		}
+	}
+	for validatorIndex, target := range targets {
+		s.applyVoteLocked(validatorIndex, target, balance(validatorIndex))
+	}
+}
+
+func (s *forkChoiceStore) applyVoteLocked(validatorIndex uint64, target [32]byte, balance uint64) {
+	_, targetKnown := s.nodes[target]
+	old, ok := s.votes[validatorIndex]
+	if ok && old.root == target && old.balance == balance && (old.applied || !targetKnown) {
+		return
+	}
+	if ok && old.applied {
+		s.decreaseWeight(old.root, old.balance)
+	}
+	vote := &forkChoiceVote{root: target, balance: balance}
+	if targetKnown {
+		s.increaseWeight(target, balance)
+		vote.applied = true
+	}
+	s.votes[validatorIndex] = vote
+}
+
+func (s *forkChoiceStore) increaseWeight(root [32]byte, amount uint64) {
+	for node := s.nodes[root]; node != nil; node = node.parent {
+		node.weight += amount
+	}
+}
+
+func (s *forkChoiceStore) decreaseWeight(root [32]byte, amount uint64) {
+	for node := s.nodes[root]; node != nil; node = node.parent {
+		node.weight -= amount
+	}
+}
+
+// ancestor returns the root of the ancestor of the block with the given root at or before
+// the given slot. If the slot is before the root of the tree, the root of the tree is returned.
+func (s *forkChoiceStore) ancestor(root [32]byte, slot uint64) ([32]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	node, ok := s.nodes[root]
+	if !ok {
+		return [32]byte{}, fmt.Errorf("block %#x is not in the fork-choice store", root)
+	}
+	for node.block.Slot > slot && node.parent != nil {
+		node = node.parent
+	}
+	return node.root, nil
+}
+
+// head runs LMD GHOST from the given justified block root, repeatedly moving to the child
+// with the heaviest weight until a leaf is reached. Ties are broken in favor of the
+// child with the lexicographically higher block root.
+func (s *forkChoiceStore) head(justifiedRoot [32]byte) (*pb.BeaconBlock, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	node, ok := s.nodes[justifiedRoot]
+	if !ok {
+		return nil, fmt.Errorf("justified block %#x is not in the fork-choice store", justifiedRoot)
+	}
+	for len(node.children) > 0 {
+		best := node.children[0]
+		for _, child := range node.children[1:] {
+			if child.weight > best.weight ||
+				(child.weight == best.weight && bytes.Compare(child.root[:], best.root[:]) > 0) {
+				best = child
+			}
+		}
+		node = best
+	}
+	return node.block, nil
+}
+
+// prune re-roots the tree at the given finalized block root, discarding every block
+// which does not descend from it. Votes for discarded blocks are no longer applied.
+func (s *forkChoiceStore) prune(finalizedRoot [32]byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	newRoot, ok := s.nodes[finalizedRoot]
+	if !ok {
+		return fmt.Errorf("finalized block %#x is not in the fork-choice store", finalizedRoot)
+	}
+	if newRoot == s.root {
+		return nil
+	}
+
+	kept := make(map[[32]byte]*forkChoiceNode)
+	queue := []*forkChoiceNode{newRoot}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		kept[node.root] = node
+		queue = append(queue, node.children...)
+	}
+	newRoot.parent = nil
+	s.root = newRoot
+	s.nodes = kept
+
+	for _, vote := range s.votes {
+		if _, ok := kept[vote.root]; !ok {
+			vote.applied = false
+		}
+	}
+	return nil
+}
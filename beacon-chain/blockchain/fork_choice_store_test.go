@@ -0,0 +1,202 @@
+package blockchain
+
+import (
+	"bytes"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// forkChoiceTestTree builds a store rooted at a block at slot 0 with the following blocks:
+//
+//        /- b1 - b3
+//   root
+//        \- b2
+func forkChoiceTestTree(t *testing.T) (*forkChoiceStore, map[string][32]byte) {
+	rootBlock := &pb.BeaconBlock{Slot: 0}
+	store, err := newForkChoiceStore(rootBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootHash, err := hashutil.HashBeaconBlock(rootBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := map[string][32]byte{"root": rootHash}
+	insert := func(name string, slot uint64, parent string) {
+		parentRoot := roots[parent]
+		block := &pb.BeaconBlock{Slot: slot, ParentRootHash32: parentRoot[:], StateRootHash32: []byte(name)}
+		if err := store.insertBlock(block); err != nil {
+			t.Fatalf("Could not insert block %s: %v", name, err)
+		}
+		roots[name], err = hashutil.HashBeaconBlock(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	insert("b1", 1, "root")
+	insert("b2", 1, "root")
+	insert("b3", 2, "b1")
+	return store, roots
+}
+
+func constantBalance(balance uint64) func(uint64) uint64 {
+	return func(uint64) uint64 {
+		return balance
+	}
+}
+
+func TestForkChoiceStore_InsertBlockRequiresParent(t *testing.T) {
+	store, _ := forkChoiceTestTree(t)
+	orphan := &pb.BeaconBlock{Slot: 5, ParentRootHash32: []byte{'a'}}
+	if err := store.insertBlock(orphan); err == nil {
+		t.Error("Expected inserting a block without a known parent to fail")
+	}
+}
+
+func TestForkChoiceStore_VotesUpdateWeights(t *testing.T) {
+	store, roots := forkChoiceTestTree(t)
+	store.applyVotes(map[uint64][32]byte{0: roots["b3"], 1: roots["b2"]}, constantBalance(10))
+
+	wanted := map[string]uint64{"root": 20, "b1": 10, "b2": 10, "b3": 10}
+	for name, weight := range wanted {
+		if store.nodes[roots[name]].weight != weight {
+			t.Errorf("Expected weight of %s to be %d, received %d", name, weight, store.nodes[roots[name]].weight)
+		}
+	}
+
+	// Validator 1 switches its vote to b3 and validator 0 stops voting.
+	store.applyVotes(map[uint64][32]byte{1: roots["b3"]}, constantBalance(10))
+	wanted = map[string]uint64{"root": 10, "b1": 10, "b2": 0, "b3": 10}
+	for name, weight := range wanted {
+		if store.nodes[roots[name]].weight != weight {
+			t.Errorf("Expected weight of %s to be %d, received %d", name, weight, store.nodes[roots[name]].weight)
+		}
+	}
+}
+
+func TestForkChoiceStore_VoteForUnknownBlockAppliedOnInsert(t *testing.T) {
+	store, roots := forkChoiceTestTree(t)
+	parentRoot := roots["b2"]
+	b4 := &pb.BeaconBlock{Slot: 3, ParentRootHash32: parentRoot[:]}
+	b4Root, err := hashutil.HashBeaconBlock(b4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store.applyVotes(map[uint64][32]byte{0: b4Root}, constantBalance(10))
+	if store.root.weight != 0 {
+		t.Errorf("Expected vote for unknown block not to be applied, root weight is %d", store.root.weight)
+	}
+	if err := store.insertBlock(b4); err != nil {
+		t.Fatal(err)
+	}
+	store.applyVotes(map[uint64][32]byte{0: b4Root}, constantBalance(10))
+	if store.nodes[roots["b2"]].weight != 10 {
+		t.Errorf("Expected vote to be applied once the block is known, b2 weight is %d",
+			store.nodes[roots["b2"]].weight)
+	}
+}
+
+func TestForkChoiceStore_HeadFollowsHeaviestChild(t *testing.T) {
+	store, roots := forkChoiceTestTree(t)
+	store.applyVotes(map[uint64][32]byte{0: roots["b2"], 1: roots["b2"], 2: roots["b3"]}, constantBalance(10))
+
+	head, err := store.head(roots["root"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	headRoot, err := hashutil.HashBeaconBlock(head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if headRoot != roots["b2"] {
+		t.Errorf("Expected head to be b2, received block at slot %d", head.Slot)
+	}
+
+	// Running fork choice from b1 only considers its descendants.
+	head, err = store.head(roots["b1"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	headRoot, err = hashutil.HashBeaconBlock(head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if headRoot != roots["b3"] {
+		t.Errorf("Expected head to be b3, received block at slot %d", head.Slot)
+	}
+}
+
+func TestForkChoiceStore_HeadTieBreaksOnRoot(t *testing.T) {
+	store, roots := forkChoiceTestTree(t)
+	store.applyVotes(map[uint64][32]byte{0: roots["b1"], 1: roots["b2"]}, constantBalance(10))
+
+	head, err := store.head(roots["root"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	headRoot, err := hashutil.HashBeaconBlock(head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b1, b2 := roots["b1"], roots["b2"]
+	wanted := roots["b3"]
+	if bytes.Compare(b2[:], b1[:]) > 0 {
+		wanted = b2
+	}
+	if headRoot != wanted {
+		t.Errorf("Expected tie to be broken in favor of the higher root %#x, received %#x", wanted, headRoot)
+	}
+}
+
+func TestForkChoiceStore_Ancestor(t *testing.T) {
+	store, roots := forkChoiceTestTree(t)
+	tests := []struct {
+		slot   uint64
+		wanted string
+	}{
+		{slot: 2, wanted: "b3"},
+		{slot: 1, wanted: "b1"},
+		{slot: 0, wanted: "root"},
+	}
+	for _, tt := range tests {
+		ancestor, err := store.ancestor(roots["b3"], tt.slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ancestor != roots[tt.wanted] {
+			t.Errorf("Expected ancestor at slot %d to be %s", tt.slot, tt.wanted)
+		}
+	}
+}
+
+func TestForkChoiceStore_Prune(t *testing.T) {
+	store, roots := forkChoiceTestTree(t)
+	store.applyVotes(map[uint64][32]byte{0: roots["b2"], 1: roots["b3"]}, constantBalance(10))
+
+	if err := store.prune(roots["b1"]); err != nil {
+		t.Fatalf("Could not prune store: %v", err)
+	}
+	if store.rootBlock().Slot != 1 {
+		t.Errorf("Expected store to be rooted at slot 1, received %d", store.rootBlock().Slot)
+	}
+	for _, name := range []string{"root", "b2"} {
+		if store.hasBlock(roots[name]) {
+			t.Errorf("Expected %s to be pruned", name)
+		}
+	}
+	if !store.hasBlock(roots["b3"]) {
+		t.Error("Expected b3 to be kept")
+	}
+	if store.votes[0].applied {
+		t.Error("Expected vote for a pruned block to no longer be applied")
+	}
+
+	// Validator 0 moving its vote from the pruned block must not corrupt weights.
+	store.applyVotes(map[uint64][32]byte{0: roots["b3"], 1: roots["b3"]}, constantBalance(10))
+	if store.root.weight != 20 {
+		t.Errorf("Expected root weight of 20, received %d", store.root.weight)
+	}
+}
@@ -0,0 +1,91 @@
+package blockchain
+
+import (
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// defaultMaxPendingBlocks is the number of blocks parked while waiting for their parent
+// when the service is not configured with a limit.
+const defaultMaxPendingBlocks = 1024
+
+// pendingBlocks is a bounded queue of blocks which were received before their parent,
+// keyed by the root of the missing parent block.
+type pendingBlocks struct {
+	lock    sync.Mutex
+	maxSize int
+	size    int
+	blocks  map[[32]byte][]*pb.BeaconBlock
+}
+
+// newPendingBlocks creates a queue holding at most maxSize blocks.
+func newPendingBlocks(maxSize int) *pendingBlocks {
+	return &pendingBlocks{
+		maxSize: maxSize,
+		blocks:  make(map[[32]byte][]*pb.BeaconBlock),
+	}
+}
+
+// add parks a block until its parent is processed. Adding a block which is already
+// parked does nothing. It returns false if the queue is full and the block was not added.
+func (p *pendingBlocks) add(block *pb.BeaconBlock) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	parentRoot := bytesutil.ToBytes32(block.ParentRootHash32)
+	for _, parked := range p.blocks[parentRoot] {
+		if proto.Equal(parked, block) {
+			return true
+		}
+	}
+	if p.size >= p.maxSize {
+		return false
+	}
+	p.blocks[parentRoot] = append(p.blocks[parentRoot], block)
+	p.size++
+	return true
+}
+
+// take removes and returns every block waiting on the given parent root.
+func (p *pendingBlocks) take(parentRoot [32]byte) []*pb.BeaconBlock {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	children := p.blocks[parentRoot]
+	delete(p.blocks, parentRoot)
+	p.size -= len(children)
+	return children
+}
+
+// expire drops every parked block with a slot before the given slot and returns
+// the number of blocks dropped.
+func (p *pendingBlocks) expire(slot uint64) int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	expired := 0
+	for parentRoot, children := range p.blocks {
+		kept := children[:0]
+		for _, block := range children {
+			if block.Slot < slot {
+				expired++
+				continue
+			}
+			kept = append(kept, block)
+		}
+		if len(kept) == 0 {
+			delete(p.blocks, parentRoot)
+		} else {
+			p.blocks[parentRoot] = kept
+		}
+	}
+	p.size -= expired
+	return expired
+}
+
+// len returns the number of parked blocks.
+func (p *pendingBlocks) len() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.size
+}
@@ -0,0 +1,73 @@
+package blockchain
+
+import (
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestPendingBlocks_TakeReturnsChildren(t *testing.T) {
+	p := newPendingBlocks(10)
+	parentA, parentB := [32]byte{'a'}, [32]byte{'b'}
+	blocks := []*pb.BeaconBlock{
+		{Slot: 1, ParentRootHash32: parentA[:]},
+		{Slot: 2, ParentRootHash32: parentA[:]},
+		{Slot: 3, ParentRootHash32: parentB[:]},
+	}
+	for _, block := range blocks {
+		if !p.add(block) {
+			t.Fatalf("Could not add block at slot %d", block.Slot)
+		}
+	}
+	// Adding the same block again does not queue it twice.
+	p.add(blocks[0])
+	if p.len() != 3 {
+		t.Fatalf("Expected 3 pending blocks, received %d", p.len())
+	}
+
+	children := p.take(parentA)
+	if len(children) != 2 {
+		t.Errorf("Expected 2 children of parent A, received %d", len(children))
+	}
+	if len(p.take(parentA)) != 0 {
+		t.Error("Expected children to be removed once taken")
+	}
+	if p.len() != 1 {
+		t.Errorf("Expected 1 pending block, received %d", p.len())
+	}
+}
+
+func TestPendingBlocks_Bounded(t *testing.T) {
+	p := newPendingBlocks(2)
+	parent := [32]byte{'a'}
+	for i := uint64(0); i < 2; i++ {
+		if !p.add(&pb.BeaconBlock{Slot: i, ParentRootHash32: parent[:]}) {
+			t.Fatalf("Could not add block at slot %d", i)
+		}
+	}
+	if p.add(&pb.BeaconBlock{Slot: 2, ParentRootHash32: parent[:]}) {
+		t.Error("Expected block to be rejected by a full queue")
+	}
+}
+
+func TestPendingBlocks_ExpireBySlot(t *testing.T) {
+	p := newPendingBlocks(10)
+	parentA, parentB := [32]byte{'a'}, [32]byte{'b'}
+	p.add(&pb.BeaconBlock{Slot: 1, ParentRootHash32: parentA[:]})
+	p.add(&pb.BeaconBlock{Slot: 5, ParentRootHash32: parentA[:]})
+	p.add(&pb.BeaconBlock{Slot: 2, ParentRootHash32: parentB[:]})
+
+	if expired := p.expire(3); expired != 2 {
+		t.Errorf("Expected 2 expired blocks, received %d", expired)
+	}
+	if p.len() != 1 {
+		t.Errorf("Expected 1 pending block, received %d", p.len())
+	}
+	if len(p.take(parentB)) != 0 {
+		t.Error("Expected all children of parent B to be expired")
+	}
+	children := p.take(parentA)
+	if len(children) != 1 || children[0].Slot != 5 {
+		t.Errorf("Expected block at slot 5 to be kept, received %v", children)
+	}
+}
@@ -0,0 +1,218 @@
+package blockchain
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// Reorg is sent over the reorg feed whenever the canonical chain
+// switches from one branch to another.
+type Reorg struct {
+	OldHead        *pb.BeaconBlock
+	NewHead        *pb.BeaconBlock
+	CommonAncestor *pb.BeaconBlock
+	// Orphaned holds the blocks of the abandoned branch in descending slot order.
+	Orphaned []*pb.BeaconBlock
+}
+
+// reorganizeChain switches the canonical chain from the branch of the old head to the
+// branch of the new head. The main chain in the DB is rewritten atomically, operations
+// which only existed in the orphaned blocks are returned to the operations pool, those of
+// the new branch are removed from it and listeners of the reorg feed are notified.
+func (c *ChainService) reorganizeChain(oldHead *pb.BeaconBlock, newHead *pb.BeaconBlock, headState *pb.BeaconState) error {
+	ancestor, orphaned, branch, err := c.commonAncestor(oldHead, newHead)
+	if err != nil {
+		return fmt.Errorf("could not find common ancestor: %v", err)
+	}
+	if err := c.beaconDB.ReorgChainHead(ancestor, branch, headState); err != nil {
+		return fmt.Errorf("could not reorganize chain in DB: %v", err)
+	}
+	if err := c.reinsertOrphanedOperations(orphaned, branch); err != nil {
+		return fmt.Errorf("could not return orphaned operations to the pool: %v", err)
+	}
+	c.removeIncludedOperations(branch)
+
+	log.WithFields(logrus.Fields{
+		"oldHeadSlot":        oldHead.Slot - params.BeaconConfig().GenesisSlot,
+		"newHeadSlot":        newHead.Slot - params.BeaconConfig().GenesisSlot,
+		"commonAncestorSlot": ancestor.Slot - params.BeaconConfig().GenesisSlot,
+		"orphanedBlocks":     len(orphaned),
+	}).Warn("Chain reorganization occurred")
+	c.reorgFeed.Send(&Reorg{
+		OldHead:        oldHead,
+		NewHead:        newHead,
+		CommonAncestor: ancestor,
+		Orphaned:       orphaned,
+	})
+	return nil
+}
+
+// commonAncestor walks back from two chain heads until their branches meet. It returns the
+// common ancestor, the blocks of the old branch which become orphaned in descending slot
+// order, and the blocks of the new branch in ascending slot order. Neither list includes
+// the common ancestor.
+func (c *ChainService) commonAncestor(oldHead *pb.BeaconBlock, newHead *pb.BeaconBlock) (
+	*pb.BeaconBlock, []*pb.BeaconBlock, []*pb.BeaconBlock, error) {
+	oldRoot, err := hashutil.HashBeaconBlock(oldHead)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not tree hash old head: %v", err)
+	}
+	newRoot, err := hashutil.HashBeaconBlock(newHead)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not tree hash new head: %v", err)
+	}
+
+	var orphaned []*pb.BeaconBlock
+	var branch []*pb.BeaconBlock
+	oldBlock, newBlock := oldHead, newHead
+	for oldRoot != newRoot {
+		if oldBlock.Slot >= newBlock.Slot {
+			orphaned = append(orphaned, oldBlock)
+			oldRoot = bytesutil.ToBytes32(oldBlock.ParentRootHash32)
+			oldBlock, err = c.beaconDB.Block(oldRoot)
+		} else {
+			branch = append([]*pb.BeaconBlock{newBlock}, branch...)
+			newRoot = bytesutil.ToBytes32(newBlock.ParentRootHash32)
+			newBlock, err = c.beaconDB.Block(newRoot)
+		}
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("could not get parent block: %v", err)
+		}
+		if oldBlock == nil || newBlock == nil {
+			return nil, nil, nil, fmt.Errorf("branches of %#x and %#x do not share a known ancestor",
+				oldRoot, newRoot)
+		}
+	}
+	return oldBlock, orphaned, branch, nil
+}
+
+// reinsertOrphanedOperations sends the attestations and exits of orphaned blocks back to the
+// operations pool, and returns their deposits to the pending deposits, unless the operations
+// were also included in the new canonical branch.
+func (c *ChainService) reinsertOrphanedOperations(orphaned []*pb.BeaconBlock, branch []*pb.BeaconBlock) error {
+	included := make(map[[32]byte]bool)
+	includedDeposits := make(map[uint64]bool)
+	for _, block := range branch {
+		if block.Body == nil {
+			continue
+		}
+		for _, att := range block.Body.Attestations {
+			h, err := hashutil.HashProto(att)
+			if err != nil {
+				return err
+			}
+			included[h] = true
+		}
+		for _, exit := range block.Body.VoluntaryExits {
+			h, err := hashutil.HashProto(exit)
+			if err != nil {
+				return err
+			}
+			included[h] = true
+		}
+		for _, dep := range block.Body.Deposits {
+			includedDeposits[dep.MerkleTreeIndex] = true
+		}
+	}
+
+	for _, block := range orphaned {
+		if block.Body == nil {
+			continue
+		}
+		for _, att := range block.Body.Attestations {
+			h, err := hashutil.HashProto(att)
+			if err != nil {
+				return err
+			}
+			if !included[h] {
+				c.opsPoolService.IncomingAttFeed().Send(att)
+			}
+		}
+		for _, exit := range block.Body.VoluntaryExits {
+			h, err := hashutil.HashProto(exit)
+			if err != nil {
+				return err
+			}
+			if !included[h] {
+				c.opsPoolService.IncomingExitFeed().Send(exit)
+			}
+		}
+		for _, dep := range block.Body.Deposits {
+			if !includedDeposits[dep.MerkleTreeIndex] {
+				// The deposit was already past the ETH1 follow distance when it was first
+				// included, so it is returned as immediately includable.
+				c.beaconDB.InsertPendingDeposit(c.ctx, dep, big.NewInt(0))
+			}
+		}
+	}
+	return nil
+}
+
+// ancestorAtSlot walks back from the given block through its parents and returns the first
+// ancestor at or before the given slot, along with the blocks visited on the way there in
+// descending slot order, excluding the starting block. If the walk reaches a block whose
+// parent is unknown, such as the genesis block, that block is returned as the ancestor.
+func (c *ChainService) ancestorAtSlot(block *pb.BeaconBlock, slot uint64) (*pb.BeaconBlock, []*pb.BeaconBlock, error) {
+	var visited []*pb.BeaconBlock
+	current := block
+	for current.Slot > slot {
+		parent, err := c.beaconDB.Block(bytesutil.ToBytes32(current.ParentRootHash32))
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not get parent block: %v", err)
+		}
+		if parent == nil {
+			break
+		}
+		if current != block {
+			visited = append(visited, current)
+		}
+		current = parent
+	}
+	return current, visited, nil
+}
+
+// regenerateState returns the post-state of the given block. The state is taken from the
+// side-branch cache if the block was processed recently, otherwise the state generator
+// replays the branch of the block from the closest stored state.
+func (c *ChainService) regenerateState(block *pb.BeaconBlock) (*pb.BeaconState, error) {
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return nil, fmt.Errorf("could not tree hash block: %v", err)
+	}
+	if beaconState := c.branchStates.get(root); beaconState != nil {
+		return beaconState, nil
+	}
+	return c.stateGenerator.StateAtSlot(c.ctx, root, block.Slot)
+}
+
+// updateFinalizedState saves the state of the finalized block on the branch of the given
+// head once the head state finalizes an epoch past the currently saved finalized state.
+func (c *ChainService) updateFinalizedState(head *pb.BeaconBlock, headState *pb.BeaconState) error {
+	finalizedState, err := c.beaconDB.FinalizedState()
+	if err != nil {
+		return fmt.Errorf("could not retrieve finalized state: %v", err)
+	}
+	finalizedSlot := helpers.StartSlot(headState.FinalizedEpoch)
+	if finalizedSlot <= finalizedState.Slot {
+		return nil
+	}
+	finalizedBlock, _, err := c.ancestorAtSlot(head, finalizedSlot)
+	if err != nil {
+		return fmt.Errorf("could not retrieve finalized block: %v", err)
+	}
+	if finalizedBlock.Slot <= finalizedState.Slot {
+		return nil
+	}
+	newFinalizedState, err := c.regenerateState(finalizedBlock)
+	if err != nil {
+		return fmt.Errorf("could not regenerate finalized state: %v", err)
+	}
+	return c.beaconDB.SaveFinalizedState(newFinalizedState)
+}
@@ -0,0 +1,232 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// setupForkedChain saves a genesis block as the chain head along with two
+// competing branches built on top of it, and makes the first branch canonical.
+func setupForkedChain(t *testing.T, beaconDB *db.BeaconDB) (*pb.BeaconBlock, []*pb.BeaconBlock, []*pb.BeaconBlock) {
+	genesis := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot}
+	genesisRoot, err := hashutil.HashBeaconBlock(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveBlock(genesis); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.UpdateChainHead(genesis, &pb.BeaconState{}); err != nil {
+		t.Fatal(err)
+	}
+
+	oldBranch := make([]*pb.BeaconBlock, 2)
+	newBranch := make([]*pb.BeaconBlock, 2)
+	oldParent, newParent := genesisRoot, genesisRoot
+	for i := 0; i < 2; i++ {
+		oldBranch[i] = &pb.BeaconBlock{
+			Slot:             params.BeaconConfig().GenesisSlot + uint64(i) + 1,
+			ParentRootHash32: oldParent[:],
+			Body: &pb.BeaconBlockBody{
+				Attestations: []*pb.Attestation{{
+					Data: &pb.AttestationData{Slot: uint64(i)},
+				}},
+			},
+		}
+		newBranch[i] = &pb.BeaconBlock{
+			Slot:             params.BeaconConfig().GenesisSlot + uint64(i) + 2,
+			ParentRootHash32: newParent[:],
+			Body: &pb.BeaconBlockBody{
+				// The new branch also includes the attestation of the first old block.
+				Attestations: []*pb.Attestation{{
+					Data: &pb.AttestationData{Slot: 0},
+				}},
+			},
+		}
+		for _, block := range []*pb.BeaconBlock{oldBranch[i], newBranch[i]} {
+			if err := beaconDB.SaveBlock(block); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := beaconDB.UpdateChainHead(oldBranch[i], &pb.BeaconState{}); err != nil {
+			t.Fatal(err)
+		}
+		oldParent, err = hashutil.HashBeaconBlock(oldBranch[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		newParent, err = hashutil.HashBeaconBlock(newBranch[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return genesis, oldBranch, newBranch
+}
+
+func TestCommonAncestor_FindsForkPoint(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	chainService := setupBeaconChain(t, false, beaconDB, true, nil)
+	genesis, oldBranch, newBranch := setupForkedChain(t, beaconDB)
+
+	ancestor, orphaned, branch, err := chainService.commonAncestor(oldBranch[1], newBranch[1])
+	if err != nil {
+		t.Fatalf("Could not find common ancestor: %v", err)
+	}
+	if !proto.Equal(ancestor, genesis) {
+		t.Errorf("Expected common ancestor %v, received %v", genesis, ancestor)
+	}
+	if len(orphaned) != 2 || !proto.Equal(orphaned[0], oldBranch[1]) || !proto.Equal(orphaned[1], oldBranch[0]) {
+		t.Errorf("Expected orphaned blocks in descending slot order, received %v", orphaned)
+	}
+	if len(branch) != 2 || !proto.Equal(branch[0], newBranch[0]) || !proto.Equal(branch[1], newBranch[1]) {
+		t.Errorf("Expected new branch in ascending slot order, received %v", branch)
+	}
+}
+
+func TestReorganizeChain_RewritesMainChain(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	opsService := &mockOperationService{}
+	chainService := setupBeaconChain(t, false, beaconDB, true, nil)
+	chainService.opsPoolService = opsService
+	genesis, oldBranch, newBranch := setupForkedChain(t, beaconDB)
+
+	reorgChan := make(chan *Reorg, 1)
+	reorgSub := chainService.ReorgFeed().Subscribe(reorgChan)
+	defer reorgSub.Unsubscribe()
+	attChan := make(chan *pb.Attestation, 2)
+	attSub := opsService.IncomingAttFeed().Subscribe(attChan)
+	defer attSub.Unsubscribe()
+	processedChan := make(chan *pb.BeaconBlock, 4)
+	processedSub := opsService.IncomingProcessedBlockFeed().Subscribe(processedChan)
+	defer processedSub.Unsubscribe()
+
+	headState := &pb.BeaconState{Slot: newBranch[1].Slot}
+	if err := chainService.reorganizeChain(oldBranch[1], newBranch[1], headState); err != nil {
+		t.Fatalf("Could not reorganize chain: %v", err)
+	}
+
+	head, err := beaconDB.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(head, newBranch[1]) {
+		t.Errorf("Expected head %v, received %v", newBranch[1], head)
+	}
+	for _, block := range append(oldBranch, newBranch...) {
+		canonical, err := beaconDB.BlockBySlot(block.Slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		isNewBranch := proto.Equal(block, newBranch[0]) || proto.Equal(block, newBranch[1])
+		if isNewBranch && !proto.Equal(canonical, block) {
+			t.Errorf("Expected block %v to be canonical at slot %d, received %v", block, block.Slot, canonical)
+		}
+		if !isNewBranch && proto.Equal(canonical, block) {
+			t.Errorf("Expected orphaned block at slot %d to be removed from the main chain", block.Slot)
+		}
+	}
+	savedState, err := beaconDB.State(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(savedState, headState) {
+		t.Errorf("Expected head state %v, received %v", headState, savedState)
+	}
+
+	select {
+	case reorg := <-reorgChan:
+		if !proto.Equal(reorg.OldHead, oldBranch[1]) || !proto.Equal(reorg.NewHead, newBranch[1]) ||
+			!proto.Equal(reorg.CommonAncestor, genesis) {
+			t.Errorf("Unexpected reorg event %v", reorg)
+		}
+		if len(reorg.Orphaned) != 2 || !proto.Equal(reorg.Orphaned[0], oldBranch[1]) || !proto.Equal(reorg.Orphaned[1], oldBranch[0]) {
+			t.Errorf("Expected the orphaned blocks in the reorg event, received %v", reorg.Orphaned)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected reorg event to be sent")
+	}
+
+	// Only the attestation which is not part of the new branch goes back to the pool.
+	select {
+	case att := <-attChan:
+		if att.Data.Slot != 1 {
+			t.Errorf("Expected orphaned attestation for slot 1, received slot %d", att.Data.Slot)
+		}
+	default:
+		t.Fatal("Expected orphaned attestation to be returned to the operations pool")
+	}
+	if len(attChan) != 0 {
+		t.Errorf("Expected a single orphaned attestation, %d more were sent", len(attChan))
+	}
+
+	// Only the blocks of the new branch have their operations removed from the pool.
+	if len(processedChan) != len(newBranch) {
+		t.Fatalf("Expected %d blocks sent to the operations pool, received %d", len(newBranch), len(processedChan))
+	}
+	for _, block := range newBranch {
+		if processed := <-processedChan; !proto.Equal(processed, block) {
+			t.Errorf("Expected block %v sent to the operations pool, received %v", block, processed)
+		}
+	}
+	testutil.AssertLogsContain(t, hook, "Chain reorganization occurred")
+}
+
+func TestRegenerateState_DistinguishesSiblingsAtFinalizedSlot(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	beaconDB.SetStateStoragePolicy(db.StoreAllStates)
+	chainService := setupBeaconChain(t, false, beaconDB, true, nil)
+	genesis, oldBranch, _ := setupForkedChain(t, beaconDB)
+	genesisRoot, err := hashutil.HashBeaconBlock(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Two blocks at the same slot, the first of which is finalized.
+	sibling := &pb.BeaconBlock{
+		Slot:             oldBranch[0].Slot,
+		ParentRootHash32: genesisRoot[:],
+		StateRootHash32:  []byte{'a'},
+	}
+	if err := beaconDB.SaveBlock(sibling); err != nil {
+		t.Fatal(err)
+	}
+	finalizedState := &pb.BeaconState{Slot: oldBranch[0].Slot, GenesisTime: 1}
+	siblingState := &pb.BeaconState{Slot: sibling.Slot, GenesisTime: 2}
+	for block, beaconState := range map[*pb.BeaconBlock]*pb.BeaconState{
+		oldBranch[0]: finalizedState,
+		sibling:      siblingState,
+	} {
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := beaconDB.SaveStateForBlock(root, beaconState); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := beaconDB.SaveFinalizedState(finalizedState); err != nil {
+		t.Fatal(err)
+	}
+
+	regenerated, err := chainService.regenerateState(sibling)
+	if err != nil {
+		t.Fatalf("Could not regenerate state: %v", err)
+	}
+	if !proto.Equal(regenerated, siblingState) {
+		t.Errorf("Expected state of the sibling block %v, received %v", siblingState, regenerated)
+	}
+}
@@ -4,6 +4,7 @@
 package blockchain
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"time"
@@ -11,13 +12,14 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/stategenerator"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
-	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -26,8 +28,23 @@ import (
 
 var log = logrus.WithField("prefix", "blockchain")
 
+// stateGeneratorCacheSize is the number of regenerated states kept in memory, enough for the
+// head and finalized states of a competing branch.
+const stateGeneratorCacheSize = 4
+
 type operationService interface {
 	IncomingProcessedBlockFeed() *event.Feed
+	IncomingAttFeed() *event.Feed
+	IncomingExitFeed() *event.Feed
+}
+
+// CheckpointUpdate is sent over the checkpoint feed whenever a new chain head changes
+// the justified or the finalized epoch of the canonical chain.
+type CheckpointUpdate struct {
+	OldJustifiedEpoch uint64
+	JustifiedEpoch    uint64
+	OldFinalizedEpoch uint64
+	FinalizedEpoch    uint64
 }
 
 // ChainService represents a service that handles the internal
@@ -44,9 +61,18 @@ type ChainService struct {
 	chainStartChan       chan time.Time
 	canonicalBlockFeed   *event.Feed
 	canonicalStateFeed   *event.Feed
+	reorgFeed            *event.Feed
+	checkpointFeed       *event.Feed
+	justifiedEpoch       uint64
+	finalizedEpoch       uint64
 	genesisTime          time.Time
 	enablePOWChain       bool
 	stateInitializedFeed *event.Feed
+	forkChoiceStore      *forkChoiceStore
+	pendingBlocks        *pendingBlocks
+	branchStates         *branchStates
+	stateGenerator       *stategenerator.StateGenerator
+	blockRequestFeed     *event.Feed
 }
 
 // Config options for the service.
@@ -57,21 +83,24 @@ type Config struct {
 	AttsService      *attestation.Service
 	BeaconDB         *db.BeaconDB
 	OpsPoolService   operationService
+	MaxPendingBlocks int // Zero uses defaultMaxPendingBlocks.
 	DevMode          bool
 	EnablePOWChain   bool
 }
 
-// attestationTarget consists of validator index and block, it's
-// used to represent which validator index has voted which block.
-type attestationTarget struct {
-	validatorIndex uint64
-	block          *pb.BeaconBlock
-}
-
 // NewChainService instantiates a new service instance that will
 // be registered into a running beacon node.
 func NewChainService(ctx context.Context, cfg *Config) (*ChainService, error) {
 	ctx, cancel := context.WithCancel(ctx)
+	maxPendingBlocks := cfg.MaxPendingBlocks
+	if maxPendingBlocks <= 0 {
+		maxPendingBlocks = defaultMaxPendingBlocks
+	}
+	stateGenerator, err := stategenerator.NewStateGenerator(cfg.BeaconDB, stateGeneratorCacheSize)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
 	return &ChainService{
 		ctx:                  ctx,
 		cancel:               cancel,
@@ -84,7 +113,13 @@ func NewChainService(ctx context.Context, cfg *Config) (*ChainService, error) {
 		incomingBlockFeed:    new(event.Feed),
 		canonicalBlockFeed:   new(event.Feed),
 		canonicalStateFeed:   new(event.Feed),
+		reorgFeed:            new(event.Feed),
+		checkpointFeed:       new(event.Feed),
 		stateInitializedFeed: new(event.Feed),
+		pendingBlocks:        newPendingBlocks(maxPendingBlocks),
+		branchStates:         newBranchStates(maxBranchStates),
+		stateGenerator:       stateGenerator,
+		blockRequestFeed:     new(event.Feed),
 		enablePOWChain:       cfg.EnablePOWChain,
 	}, nil
 }
@@ -99,6 +134,18 @@ func (c *ChainService) Start() {
 	if beaconState != nil {
 		log.Info("Beacon chain data already exists, starting service")
 		c.genesisTime = time.Unix(int64(beaconState.GenesisTime), 0)
+		head, err := c.beaconDB.ChainHead()
+		if err != nil {
+			log.Fatalf("Could not fetch chain head: %v", err)
+		}
+		if err := c.initForkChoiceStore(head, beaconState); err != nil {
+			log.Fatalf("Could not initialize fork-choice store: %v", err)
+		}
+		c.justifiedEpoch = beaconState.JustifiedEpoch
+		c.finalizedEpoch = beaconState.FinalizedEpoch
+		if err := c.beaconDB.RebuildValidatorIndex(beaconState); err != nil {
+			log.Fatalf("Could not rebuild validator index: %v", err)
+		}
 		go c.blockProcessing()
 	} else {
 		log.Info("Waiting for ChainStart log from the Validator Deposit Contract to start the beacon chain...")
@@ -154,6 +201,11 @@ func (c *ChainService) initializeBeaconChain(genesisTime time.Time, deposits []*
 	if err := c.beaconDB.UpdateChainHead(genBlock, beaconState); err != nil {
 		return nil, fmt.Errorf("could not set chain head, %v", err)
 	}
+	if err := c.initForkChoiceStore(genBlock, beaconState); err != nil {
+		return nil, fmt.Errorf("could not initialize fork-choice store: %v", err)
+	}
+	c.justifiedEpoch = beaconState.JustifiedEpoch
+	c.finalizedEpoch = beaconState.FinalizedEpoch
 	return beaconState, nil
 }
 
@@ -189,6 +241,24 @@ func (c *ChainService) CanonicalStateFeed() *event.Feed {
 	return c.canonicalStateFeed
 }
 
+// ReorgFeed returns a feed that is written to whenever the canonical
+// chain switches to a different branch.
+func (c *ChainService) ReorgFeed() *event.Feed {
+	return c.reorgFeed
+}
+
+// CheckpointFeed returns a feed that is written to whenever a new chain head
+// changes the justified or the finalized epoch of the canonical chain.
+func (c *ChainService) CheckpointFeed() *event.Feed {
+	return c.checkpointFeed
+}
+
+// BlockRequestFeed returns a feed that is written to with the root of a missing
+// parent block whenever a block is received before its parent.
+func (c *ChainService) BlockRequestFeed() *event.Feed {
+	return c.blockRequestFeed
+}
+
 // StateInitializedFeed returns a feed that is written to
 // when the beacon state is first initialized.
 func (c *ChainService) StateInitializedFeed() *event.Feed {
@@ -234,54 +304,215 @@ func (c *ChainService) blockProcessing() {
 
 		// Listen for a newly received incoming block from the feed. Blocks
 		// can be received either from the sync service, the RPC service,
-		// or via p2p.
+		// or via p2p. Once a block is processed, any blocks which were
+		// waiting on it as their parent are processed as well.
 		case block := <-c.incomingBlockChan:
-			beaconState, err := c.beaconDB.State(c.ctx)
-			if err != nil {
-				log.Errorf("Unable to retrieve beacon state %v", err)
-				continue
-			}
+			queue := []*pb.BeaconBlock{block}
+			for len(queue) > 0 {
+				block := queue[0]
+				queue = queue[1:]
+				if !c.beaconDB.HasBlock(bytesutil.ToBytes32(block.ParentRootHash32)) {
+					c.parkBlock(block)
+					continue
+				}
 
-			if block.Slot > beaconState.Slot {
+				beaconState, onHead, err := c.parentState(block)
+				if err != nil {
+					log.Errorf("Unable to retrieve beacon state %v", err)
+					continue
+				}
+				if block.Slot <= beaconState.Slot {
+					continue
+				}
 				computedState, err := c.ReceiveBlock(block, beaconState)
 				if err != nil {
 					log.Errorf("Could not process received block: %v", err)
 					continue
 				}
+				blockRoot, err := hashutil.HashBeaconBlock(block)
+				if err != nil {
+					log.Errorf("Could not tree hash block: %v", err)
+					continue
+				}
+				if !onHead {
+					c.branchStates.add(blockRoot, computedState)
+				}
 				if err := c.ApplyForkChoiceRule(block, computedState); err != nil {
 					log.Errorf("Could not update chain head: %v", err)
 					continue
 				}
+				c.expirePendingBlocks(computedState.Slot)
+				queue = append(queue, c.pendingBlocks.take(blockRoot)...)
 			}
 		}
 	}
 }
 
+// parkBlock queues a block whose parent has not been processed yet and requests
+// the missing parent from peers.
+func (c *ChainService) parkBlock(block *pb.BeaconBlock) {
+	parentRoot := bytesutil.ToBytes32(block.ParentRootHash32)
+	fields := logrus.Fields{
+		"slot":       block.Slot - params.BeaconConfig().GenesisSlot,
+		"parentRoot": fmt.Sprintf("%#x", parentRoot),
+	}
+	if !c.pendingBlocks.add(block) {
+		log.WithFields(fields).Warn("Pending block queue is full, dropping block with unknown parent")
+		return
+	}
+	log.WithFields(fields).Debug("Parent block not processed yet, queueing block and requesting parent")
+	c.blockRequestFeed.Send(parentRoot)
+}
+
+// expirePendingBlocks drops parked blocks which are more than an epoch behind the given slot.
+// Their parent most likely belongs to an abandoned branch by then, and if it does not, the
+// blocks are fetched again by initial sync.
+func (c *ChainService) expirePendingBlocks(slot uint64) {
+	if slot < params.BeaconConfig().SlotsPerEpoch {
+		return
+	}
+	if expired := c.pendingBlocks.expire(slot - params.BeaconConfig().SlotsPerEpoch); expired > 0 {
+		log.WithField("expiredBlocks", expired).Debug("Dropped expired blocks from the pending block queue")
+	}
+}
+
+// parentState returns the post-state of the given block's parent, and whether the block
+// extends the current head. This is the canonical state when the block extends the current
+// head, otherwise the state is regenerated for the branch the block builds upon.
+func (c *ChainService) parentState(block *pb.BeaconBlock) (*pb.BeaconState, bool, error) {
+	headRoot, err := c.ChainHeadRoot()
+	if err != nil {
+		return nil, false, err
+	}
+	if bytes.Equal(block.ParentRootHash32, headRoot[:]) {
+		beaconState, err := c.beaconDB.State(c.ctx)
+		return beaconState, true, err
+	}
+	parent, err := c.beaconDB.Block(bytesutil.ToBytes32(block.ParentRootHash32))
+	if err != nil {
+		return nil, false, fmt.Errorf("could not retrieve parent block: %v", err)
+	}
+	if parent == nil {
+		return nil, false, fmt.Errorf("parent block %#x does not exist", block.ParentRootHash32)
+	}
+	beaconState, err := c.regenerateState(parent)
+	return beaconState, false, err
+}
+
 // ApplyForkChoiceRule determines the current beacon chain head using LMD GHOST as a block-vote
-// weighted function to select a canonical head in Ethereum Serenity.
+// weighted function to select a canonical head in Ethereum Serenity. The fork choice starts
+// from the justified block of the computed state. If the winning head is on a different
+// branch than the current head, the chain is reorganized onto the winning branch.
 func (c *ChainService) ApplyForkChoiceRule(block *pb.BeaconBlock, computedState *pb.BeaconState) error {
 	h, err := hashutil.HashBeaconBlock(block)
 	if err != nil {
 		return fmt.Errorf("could not tree hash incoming block: %v", err)
 	}
-	// TODO(#1307): Use LMD GHOST as the fork-choice rule for Ethereum Serenity.
-	// TODO(#674): Handle chain reorgs.
-	if err := c.beaconDB.UpdateChainHead(block, computedState); err != nil {
-		return fmt.Errorf("failed to update chain: %v", err)
+	if c.forkChoiceStore == nil {
+		if err := c.initForkChoiceStore(block, computedState); err != nil {
+			return fmt.Errorf("could not initialize fork-choice store: %v", err)
+		}
+	}
+	if err := c.insertForkChoiceBlock(block); err != nil {
+		return fmt.Errorf("could not insert block into fork-choice store: %v", err)
+	}
+	justifiedRoot, err := c.forkChoiceStore.ancestor(h, helpers.StartSlot(computedState.JustifiedEpoch))
+	if err != nil {
+		return fmt.Errorf("could not retrieve justified block: %v", err)
+	}
+
+	targets, err := c.attestationTargets(computedState)
+	if err != nil {
+		return fmt.Errorf("could not retrieve attestation targets: %v", err)
 	}
-	log.WithField("blockRoot", fmt.Sprintf("0x%x", h)).Info("Chain head block and state updated")
+	c.forkChoiceStore.applyVotes(targets, func(validatorIndex uint64) uint64 {
+		return helpers.EffectiveBalance(computedState, validatorIndex)
+	})
+	head, err := c.forkChoiceStore.head(justifiedRoot)
+	if err != nil {
+		return fmt.Errorf("could not run fork choice: %v", err)
+	}
+	headRoot, err := hashutil.HashBeaconBlock(head)
+	if err != nil {
+		return fmt.Errorf("could not tree hash head block: %v", err)
+	}
+	currentHead, err := c.beaconDB.ChainHead()
+	if err != nil {
+		return fmt.Errorf("could not retrieve chain head: %v", err)
+	}
+	currentHeadRoot, err := hashutil.HashBeaconBlock(currentHead)
+	if err != nil {
+		return fmt.Errorf("could not tree hash chain head: %v", err)
+	}
+	if headRoot == currentHeadRoot {
+		log.WithFields(logrus.Fields{
+			"blockRoot": fmt.Sprintf("%#x", h),
+			"headRoot":  fmt.Sprintf("%#x", headRoot),
+		}).Debug("Block did not win fork choice, chain head not updated")
+		return nil
+	}
+
+	headState := computedState
+	if headRoot != h {
+		headState, err = c.regenerateState(head)
+		if err != nil {
+			return fmt.Errorf("could not regenerate head state: %v", err)
+		}
+	}
+	if bytes.Equal(head.ParentRootHash32, currentHeadRoot[:]) {
+		if err := c.beaconDB.UpdateChainHead(head, headState); err != nil {
+			return fmt.Errorf("failed to update chain: %v", err)
+		}
+		c.removeIncludedOperations([]*pb.BeaconBlock{head})
+	} else {
+		if err := c.reorganizeChain(currentHead, head, headState); err != nil {
+			return fmt.Errorf("failed to reorganize chain: %v", err)
+		}
+	}
+	log.WithField("blockRoot", fmt.Sprintf("0x%x", headRoot)).Info("Chain head block and state updated")
+
+	if err := c.updateFinalizedState(head, headState); err != nil {
+		log.Errorf("Could not update finalized state: %v", err)
+	}
+	finalizedRoot, err := c.forkChoiceStore.ancestor(headRoot, helpers.StartSlot(headState.FinalizedEpoch))
+	if err != nil {
+		return fmt.Errorf("could not retrieve finalized block: %v", err)
+	}
+	if err := c.forkChoiceStore.prune(finalizedRoot); err != nil {
+		return fmt.Errorf("could not prune fork-choice store: %v", err)
+	}
+	c.branchStates.expire(helpers.StartSlot(headState.FinalizedEpoch))
+
 	// We fire events that notify listeners of a new block in
 	// the case of a state transition. This is useful for the beacon node's gRPC
 	// server to stream these events to beacon clients.
 	// When the transition is a cycle transition, we stream the state containing the new validator
 	// assignments to clients.
-	if block.Slot%params.BeaconConfig().SlotsPerEpoch == 0 {
-		c.canonicalStateFeed.Send(computedState)
+	if head.Slot%params.BeaconConfig().SlotsPerEpoch == 0 {
+		c.canonicalStateFeed.Send(headState)
 	}
-	c.canonicalBlockFeed.Send(block)
+	c.canonicalBlockFeed.Send(head)
+	c.notifyCheckpointUpdate(headState)
 	return nil
 }
 
+// notifyCheckpointUpdate notifies listeners of the checkpoint feed if the given head state
+// justified or finalized a different epoch than the previous head state.
+func (c *ChainService) notifyCheckpointUpdate(headState *pb.BeaconState) {
+	if headState.JustifiedEpoch == c.justifiedEpoch && headState.FinalizedEpoch == c.finalizedEpoch {
+		return
+	}
+	update := &CheckpointUpdate{
+		OldJustifiedEpoch: c.justifiedEpoch,
+		JustifiedEpoch:    headState.JustifiedEpoch,
+		OldFinalizedEpoch: c.finalizedEpoch,
+		FinalizedEpoch:    headState.FinalizedEpoch,
+	}
+	c.justifiedEpoch = headState.JustifiedEpoch
+	c.finalizedEpoch = headState.FinalizedEpoch
+	c.checkpointFeed.Send(update)
+}
+
 // ReceiveBlock is a function that defines the operations that are preformed on
 // any block that is received from p2p layer or rpc. It checks the block to see
 // if it passes the pre-processing conditions, if it does then the per slot
@@ -321,18 +552,21 @@ func (c *ChainService) ReceiveBlock(block *pb.BeaconBlock, beaconState *pb.Beaco
 		return nil, fmt.Errorf("block with root %#x is not ready for processing: %v", blockRoot, err)
 	}
 
-	// Retrieve the last processed beacon block's hash root.
-	headRoot, err := c.ChainHeadRoot()
-	if err != nil {
-		return nil, fmt.Errorf("could not retrieve chain head root: %v", err)
-	}
+	// Empty slots before the block record its parent as the latest block root.
+	headRoot := bytesutil.ToBytes32(block.ParentRootHash32)
 
 	log.WithField("slotNumber", block.Slot-params.BeaconConfig().GenesisSlot).Info(
 		"Executing state transition")
 
+	// Epoch transitions are recorded under the latest block of the chain at the end of the epoch,
+	// which is the parent of the block for the skipped slots.
+	var performances []*pb.EpochPerformance
+	var performanceRoots [][32]byte
+
 	// Check for skipped slots.
 	for beaconState.Slot < block.Slot-1 {
-		beaconState, err = state.ExecuteStateTransition(
+		var performance *pb.EpochPerformance
+		beaconState, performance, err = state.ExecuteStateTransitionWithPerformance(
 			c.ctx,
 			beaconState,
 			nil,
@@ -342,12 +576,16 @@ func (c *ChainService) ReceiveBlock(block *pb.BeaconBlock, beaconState *pb.Beaco
 		if err != nil {
 			return nil, fmt.Errorf("could not execute state transition without block %v", err)
 		}
+		if performance != nil {
+			performances = append(performances, performance)
+			performanceRoots = append(performanceRoots, headRoot)
+		}
 		log.WithField(
 			"slotsSinceGenesis", beaconState.Slot-params.BeaconConfig().GenesisSlot,
 		).Info("Slot transition successfully processed")
 	}
 
-	beaconState, err = state.ExecuteStateTransition(
+	beaconState, performance, err := state.ExecuteStateTransitionWithPerformance(
 		c.ctx,
 		beaconState,
 		block,
@@ -357,6 +595,10 @@ func (c *ChainService) ReceiveBlock(block *pb.BeaconBlock, beaconState *pb.Beaco
 	if err != nil {
 		return nil, fmt.Errorf("could not execute state transition with block %v", err)
 	}
+	if performance != nil {
+		performances = append(performances, performance)
+		performanceRoots = append(performanceRoots, blockRoot)
+	}
 	log.WithField(
 		"slotsSinceGenesis", beaconState.Slot-params.BeaconConfig().GenesisSlot,
 	).Info("Slot transition successfully processed")
@@ -364,14 +606,6 @@ func (c *ChainService) ReceiveBlock(block *pb.BeaconBlock, beaconState *pb.Beaco
 		"slotsSinceGenesis", beaconState.Slot-params.BeaconConfig().GenesisSlot,
 	).Info("Block transition successfully processed")
 	if (beaconState.Slot+1)%params.BeaconConfig().SlotsPerEpoch == 0 {
-		// Save activated validators of this epoch to public key -> index DB.
-		if err := c.saveValidatorIdx(beaconState); err != nil {
-			return nil, fmt.Errorf("could not save validator index: %v", err)
-		}
-		// Delete exited validators of this epoch to public key -> index DB.
-		if err := c.deleteValidatorIdx(beaconState); err != nil {
-			return nil, fmt.Errorf("could not delete validator index: %v", err)
-		}
 		log.WithField(
 			"SlotsSinceGenesis", beaconState.Slot-params.BeaconConfig().GenesisSlot,
 		).Info("Epoch transition successfully processed")
@@ -381,19 +615,36 @@ func (c *ChainService) ReceiveBlock(block *pb.BeaconBlock, beaconState *pb.Beaco
 	if err := c.beaconDB.SaveBlock(block); err != nil {
 		return nil, fmt.Errorf("failed to save block: %v", err)
 	}
-
-	// Forward processed block to operation pool to remove individual operation from DB.
-	c.opsPoolService.IncomingProcessedBlockFeed().Send(block)
-
-	// Remove pending deposits from the deposit queue.
-	for _, dep := range block.Body.Deposits {
-		c.beaconDB.RemovePendingDeposit(c.ctx, dep)
+	if _, err := c.beaconDB.SaveStateForBlock(blockRoot, beaconState); err != nil {
+		return nil, fmt.Errorf("failed to save state for block: %v", err)
+	}
+	for i, performance := range performances {
+		if err := c.beaconDB.SaveEpochPerformance(performanceRoots[i], performance); err != nil {
+			return nil, fmt.Errorf("failed to save performance of epoch %d: %v",
+				performance.Epoch-params.BeaconConfig().GenesisEpoch, err)
+		}
 	}
 
 	log.WithField("hash", fmt.Sprintf("%#x", blockRoot)).Debug("Processed beacon block")
 	return beaconState, nil
 }
 
+// removeIncludedOperations removes the operations of blocks which became canonical from the
+// operations pool and the pending deposits. Blocks of side branches keep their operations
+// pooled, as the canonical chain has not included them.
+func (c *ChainService) removeIncludedOperations(blocks []*pb.BeaconBlock) {
+	for _, block := range blocks {
+		// Forward the block to the operation pool to remove individual operations from DB.
+		c.opsPoolService.IncomingProcessedBlockFeed().Send(block)
+		if block.Body == nil {
+			continue
+		}
+		for _, dep := range block.Body.Deposits {
+			c.beaconDB.RemovePendingDeposit(c.ctx, dep)
+		}
+	}
+}
+
 func (c *ChainService) isBlockReadyForProcessing(block *pb.BeaconBlock, beaconState *pb.BeaconState) error {
 	var powBlockFetcher func(ctx context.Context, hash common.Hash) (*gethTypes.Block, error)
 	if c.enablePOWChain {
@@ -406,49 +657,74 @@ func (c *ChainService) isBlockReadyForProcessing(block *pb.BeaconBlock, beaconSt
 	return nil
 }
 
-// saveValidatorIdx saves the validators public key to index mapping in DB, these
-// validators were activated from current epoch. After it saves, current epoch key
-// is deleted from ActivatedValidators mapping.
-func (c *ChainService) saveValidatorIdx(state *pb.BeaconState) error {
-	for _, idx := range validators.ActivatedValidators[helpers.CurrentEpoch(state)] {
-		pubKey := state.ValidatorRegistry[idx].Pubkey
-		if err := c.beaconDB.SaveValidatorIndex(pubKey, int(idx)); err != nil {
-			return fmt.Errorf("could not save validator index: %v", err)
+// attestationTargets retrieves the attestation targets of the validators active since the last
+// finalized epoch as a mapping of validator index to the root of the block which the validator
+// attested to. Validators without a latest attestation do not cast a vote.
+func (c *ChainService) attestationTargets(state *pb.BeaconState) (map[uint64][32]byte, error) {
+	indices := helpers.ActiveValidatorIndices(state.ValidatorRegistry, state.FinalizedEpoch)
+	attestationTargets := make(map[uint64][32]byte)
+	for _, index := range indices {
+		pubKey := bytesutil.ToBytes48(state.ValidatorRegistry[index].Pubkey)
+		attestation, ok := c.attsService.Store[pubKey]
+		if !ok || attestation.Data == nil {
+			continue
 		}
+		attestationTargets[index] = bytesutil.ToBytes32(attestation.Data.BeaconBlockRootHash32)
 	}
-	delete(validators.ActivatedValidators, helpers.CurrentEpoch(state))
-	return nil
+	return attestationTargets, nil
 }
 
-// deleteValidatorIdx deletes the validators public key to index mapping in DB, the
-// validators were exited from current epoch. After it deletes, current epoch key
-// is deleted from ExitedValidators mapping.
-func (c *ChainService) deleteValidatorIdx(state *pb.BeaconState) error {
-	for _, idx := range validators.ExitedValidators[helpers.CurrentEpoch(state)] {
-		pubKey := state.ValidatorRegistry[idx].Pubkey
-		if err := c.beaconDB.DeleteValidatorIndex(pubKey); err != nil {
-			return fmt.Errorf("could not delete validator index: %v", err)
+// initForkChoiceStore roots the fork-choice store at the finalized ancestor of the given
+// block and loads every stored descendant of the finalized block into it, following the
+// block children index, so that side branches survive a restart.
+func (c *ChainService) initForkChoiceStore(block *pb.BeaconBlock, beaconState *pb.BeaconState) error {
+	finalizedBlock, _, err := c.ancestorAtSlot(block, helpers.StartSlot(beaconState.FinalizedEpoch))
+	if err != nil {
+		return fmt.Errorf("could not retrieve finalized block: %v", err)
+	}
+	store, err := newForkChoiceStore(finalizedBlock)
+	if err != nil {
+		return err
+	}
+	finalizedRoot, err := hashutil.HashBeaconBlock(finalizedBlock)
+	if err != nil {
+		return fmt.Errorf("could not tree hash finalized block: %v", err)
+	}
+	queue := [][32]byte{finalizedRoot}
+	for len(queue) > 0 {
+		children, err := c.beaconDB.BlockChildren(queue[0])
+		if err != nil {
+			return fmt.Errorf("could not retrieve children of block %#x: %v", queue[0], err)
+		}
+		queue = queue[1:]
+		for _, child := range children {
+			if err := store.insertBlock(child); err != nil {
+				return err
+			}
+			childRoot, err := hashutil.HashBeaconBlock(child)
+			if err != nil {
+				return fmt.Errorf("could not tree hash block: %v", err)
+			}
+			queue = append(queue, childRoot)
 		}
 	}
-	delete(validators.ExitedValidators, helpers.CurrentEpoch(state))
+	c.forkChoiceStore = store
 	return nil
 }
 
-// attestationTargets retrieves the list of attestation targets since last finalized epoch,
-// each attestation target consists of validator index and its attestation target (i.e. the block
-// which the validator attested to)
-func (c *ChainService) attestationTargets(state *pb.BeaconState) ([]*attestationTarget, error) {
-	indices := helpers.ActiveValidatorIndices(state.ValidatorRegistry, state.FinalizedEpoch)
-	attestationTargets := make([]*attestationTarget, len(indices))
-	for i, index := range indices {
-		block, err := c.attsService.LatestAttestationTarget(c.ctx, index)
+// insertForkChoiceBlock adds a block to the fork-choice store, along with any of its
+// ancestors since the root of the store which have not been inserted yet.
+func (c *ChainService) insertForkChoiceBlock(block *pb.BeaconBlock) error {
+	if !c.forkChoiceStore.hasBlock(bytesutil.ToBytes32(block.ParentRootHash32)) {
+		_, ancestors, err := c.ancestorAtSlot(block, c.forkChoiceStore.rootBlock().Slot)
 		if err != nil {
-			return nil, fmt.Errorf("could not retrieve attestation target: %v", err)
+			return err
 		}
-		attestationTargets[i] = &attestationTarget{
-			validatorIndex: index,
-			block:          block,
+		for i := len(ancestors) - 1; i >= 0; i-- {
+			if err := c.forkChoiceStore.insertBlock(ancestors[i]); err != nil {
+				return err
+			}
 		}
 	}
-	return attestationTargets, nil
+	return c.forkChoiceStore.insertBlock(block)
 }
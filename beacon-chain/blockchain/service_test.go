@@ -13,11 +13,11 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
-	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
@@ -39,10 +39,22 @@ func init() {
 	logrus.SetOutput(ioutil.Discard)
 }
 
-type mockOperationService struct{}
+type mockOperationService struct {
+	processedBlockFeed event.Feed
+	attFeed            event.Feed
+	exitFeed           event.Feed
+}
 
 func (ms *mockOperationService) IncomingProcessedBlockFeed() *event.Feed {
-	return new(event.Feed)
+	return &ms.processedBlockFeed
+}
+
+func (ms *mockOperationService) IncomingAttFeed() *event.Feed {
+	return &ms.attFeed
+}
+
+func (ms *mockOperationService) IncomingExitFeed() *event.Feed {
+	return &ms.exitFeed
 }
 
 type mockClient struct{}
@@ -220,13 +232,18 @@ func setupBeaconChain(t *testing.T, faultyPoWClient bool, beaconDB *db.BeaconDB,
 		t.Fatalf("unable to set up web3 service: %v", err)
 	}
 
+	if attsService == nil {
+		attsService = attestation.NewAttestationService(ctx, &attestation.Config{BeaconDB: beaconDB})
+	}
+
 	cfg := &Config{
-		BeaconBlockBuf: 0,
-		BeaconDB:       beaconDB,
-		Web3Service:    web3Service,
-		OpsPoolService: &mockOperationService{},
-		EnablePOWChain: enablePOWChain,
-		AttsService:    attsService,
+		BeaconBlockBuf:   0,
+		BeaconDB:         beaconDB,
+		Web3Service:      web3Service,
+		OpsPoolService:   &mockOperationService{},
+		EnablePOWChain:   enablePOWChain,
+		AttsService:      attsService,
+		MaxPendingBlocks: 10,
 	}
 	if err != nil {
 		t.Fatalf("could not register blockchain service: %v", err)
@@ -371,6 +388,13 @@ func TestChainService_FaultyPOWChain(t *testing.T) {
 	if err := chainService.beaconDB.SaveBlock(parentBlock); err != nil {
 		t.Fatalf("Unable to save block %v", err)
 	}
+	beaconState, err := chainService.beaconDB.State(context.Background())
+	if err != nil {
+		t.Fatalf("Unable to retrieve beacon state %v", err)
+	}
+	if err := chainService.beaconDB.UpdateChainHead(parentBlock, beaconState); err != nil {
+		t.Fatalf("Unable to update chain head %v", err)
+	}
 
 	block := &pb.BeaconBlock{
 		Slot:             2,
@@ -457,6 +481,57 @@ func TestChainService_Starts(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Processed beacon block")
 }
 
+func TestNewChainService_DefaultsMaxPendingBlocks(t *testing.T) {
+	chainService, err := NewChainService(context.Background(), &Config{})
+	if err != nil {
+		t.Fatalf("unable to setup chain service: %v", err)
+	}
+	if chainService.pendingBlocks.maxSize != defaultMaxPendingBlocks {
+		t.Errorf("Expected %d pending blocks to be allowed, received %d",
+			defaultMaxPendingBlocks, chainService.pendingBlocks.maxSize)
+	}
+}
+
+func TestChainService_ParksBlockWithUnknownParent(t *testing.T) {
+	hook := logTest.NewGlobal()
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	chainService := setupBeaconChain(t, false, db, true, nil)
+
+	parentRoot := [32]byte{'a'}
+	block := &pb.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 5,
+		ParentRootHash32: parentRoot[:],
+	}
+
+	requestChan := make(chan [32]byte, 1)
+	requestSub := chainService.BlockRequestFeed().Subscribe(requestChan)
+	defer requestSub.Unsubscribe()
+
+	exitRoutine := make(chan bool)
+	go func() {
+		chainService.blockProcessing()
+		exitRoutine <- true
+	}()
+
+	chainService.incomingBlockChan <- block
+	select {
+	case requested := <-requestChan:
+		if requested != parentRoot {
+			t.Errorf("Expected parent %#x to be requested, received %#x", parentRoot, requested)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected missing parent to be requested")
+	}
+	chainService.cancel()
+	<-exitRoutine
+
+	if chainService.pendingBlocks.len() != 1 {
+		t.Errorf("Expected 1 pending block, received %d", chainService.pendingBlocks.len())
+	}
+	testutil.AssertLogsContain(t, hook, "Parent block not processed yet, queueing block and requesting parent")
+}
+
 func TestReceiveBlock_RemovesPendingDeposits(t *testing.T) {
 	hook := logTest.NewGlobal()
 	db := internal.SetupDB(t)
@@ -568,16 +643,6 @@ func TestUpdateHead_SavesBlock(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Cannot create genesis beacon state: %v", err)
 	}
-	stateRoot, err := hashutil.HashProto(beaconState)
-	if err != nil {
-		t.Fatalf("Could not tree hash state: %v", err)
-	}
-
-	genesis := b.NewGenesisBlock(stateRoot[:])
-	genesisRoot, err := hashutil.HashProto(genesis)
-	if err != nil {
-		t.Fatalf("Could not get genesis block root: %v", err)
-	}
 	// Table driven tests for various fork choice scenarios.
 	tests := []struct {
 		blockSlot uint64
@@ -617,6 +682,10 @@ func TestUpdateHead_SavesBlock(t *testing.T) {
 		if err := db.InitializeState(unixTime, deposits, &pb.Eth1Data{}); err != nil {
 			t.Fatalf("Could not initialize beacon state to disk: %v", err)
 		}
+		genesisRoot, err := chainService.ChainHeadRoot()
+		if err != nil {
+			t.Fatalf("Could not get genesis block root: %v", err)
+		}
 
 		stateRoot, err := hashutil.HashProto(tt.state)
 		if err != nil {
@@ -646,6 +715,75 @@ func TestUpdateHead_SavesBlock(t *testing.T) {
 	}
 }
 
+func TestApplyForkChoiceRule_HeadOnlyUpdatedByWinningBlock(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	unixTime := uint64(time.Now().Unix())
+	deposits, _ := setupInitialDeposits(t, 100)
+	if err := beaconDB.InitializeState(unixTime, deposits, &pb.Eth1Data{}); err != nil {
+		t.Fatalf("Could not initialize beacon state to disk: %v", err)
+	}
+	beaconState, err := beaconDB.State(ctx)
+	if err != nil {
+		t.Fatalf("Could not fetch beacon state: %v", err)
+	}
+	genesis, err := beaconDB.ChainHead()
+	if err != nil {
+		t.Fatalf("Could not fetch genesis block: %v", err)
+	}
+	genesisRoot, err := hashutil.HashBeaconBlock(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	candidate1 := &pb.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 1,
+		ParentRootHash32: genesisRoot[:],
+		StateRootHash32:  []byte("candidate1"),
+	}
+	candidate2 := &pb.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 1,
+		ParentRootHash32: genesisRoot[:],
+		StateRootHash32:  []byte("candidate2"),
+	}
+	for _, blk := range []*pb.BeaconBlock{candidate1, candidate2} {
+		if err := beaconDB.SaveBlock(blk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	candidate1Root, err := hashutil.HashBeaconBlock(candidate1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attsService := attestation.NewAttestationService(ctx, &attestation.Config{BeaconDB: beaconDB})
+	attsService.Store[bytesutil.ToBytes48(beaconState.ValidatorRegistry[0].Pubkey)] = &pb.Attestation{
+		Data: &pb.AttestationData{
+			BeaconBlockRootHash32: candidate1Root[:],
+		},
+	}
+	chainService := setupBeaconChain(t, false, beaconDB, true, attsService)
+
+	if err := chainService.ApplyForkChoiceRule(candidate1, beaconState); err != nil {
+		t.Fatalf("Could not apply fork choice rule: %v", err)
+	}
+	if err := chainService.ApplyForkChoiceRule(candidate2, beaconState); err != nil {
+		t.Fatalf("Could not apply fork choice rule: %v", err)
+	}
+
+	head, err := beaconDB.ChainHead()
+	if err != nil {
+		t.Fatalf("Could not fetch chain head: %v", err)
+	}
+	if !proto.Equal(head, candidate1) {
+		t.Errorf("Expected head to equal %v, received %v", candidate1, head)
+	}
+	testutil.AssertLogsContain(t, hook, "Block did not win fork choice, chain head not updated")
+}
+
 func TestIsBlockReadyForProcessing_ValidBlock(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
@@ -722,84 +860,92 @@ func TestIsBlockReadyForProcessing_ValidBlock(t *testing.T) {
 	}
 }
 
-func TestDeleteValidatorIdx_DeleteWorks(t *testing.T) {
+func TestSaveValidatorIdx_SaveRetrieveWorks(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
-	epoch := uint64(2)
-	v.ActivatedValidators[epoch] = []uint64{0, 1, 2}
-	v.ExitedValidators[epoch] = []uint64{0, 2}
-	var validators []*pb.Validator
-	for i := 0; i < 3; i++ {
-		pubKeyBuf := make([]byte, params.BeaconConfig().BLSPubkeyLength)
-		binary.PutUvarint(pubKeyBuf, uint64(i))
-		validators = append(validators, &pb.Validator{
-			Pubkey: pubKeyBuf,
-		})
-	}
-	state := &pb.BeaconState{
-		ValidatorRegistry: validators,
-		Slot:              epoch * params.BeaconConfig().SlotsPerEpoch,
-	}
+	ctx := context.Background()
 	chainService := setupBeaconChain(t, false, db, true, nil)
-	if err := chainService.saveValidatorIdx(state); err != nil {
-		t.Fatalf("Could not save validator idx: %v", err)
-	}
-	if err := chainService.deleteValidatorIdx(state); err != nil {
-		t.Fatalf("Could not delete validator idx: %v", err)
+
+	deposits, _ := setupInitialDeposits(t, 10)
+	if err := db.InitializeState(uint64(time.Now().Unix()), deposits, &pb.Eth1Data{}); err != nil {
+		t.Fatalf("Could not initialize beacon state to disk: %v", err)
 	}
-	wantedIdx := uint64(1)
-	idx, err := chainService.beaconDB.ValidatorIndex(validators[wantedIdx].Pubkey)
+	beaconState, err := db.State(ctx)
 	if err != nil {
-		t.Fatalf("Could not get validator index: %v", err)
+		t.Fatalf("Could not fetch beacon state: %v", err)
 	}
-	if wantedIdx != idx {
-		t.Errorf("Wanted: %d, got: %d", wantedIdx, idx)
+	// A validator whose deposit was processed is indexed before it is activated.
+	pubKey := make([]byte, params.BeaconConfig().BLSPubkeyLength)
+	copy(pubKey, "pending")
+	beaconState.ValidatorRegistry = append(beaconState.ValidatorRegistry, &pb.Validator{
+		Pubkey:          pubKey,
+		ActivationEpoch: params.BeaconConfig().FarFutureEpoch,
+		ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
+	})
+	if err := db.SaveState(beaconState); err != nil {
+		t.Fatalf("Could not save beacon state: %v", err)
 	}
+	setupGenesisBlock(t, chainService, beaconState)
 
-	wantedIdx = uint64(2)
-	if chainService.beaconDB.HasValidator(validators[wantedIdx].Pubkey) {
-		t.Errorf("Validator index %d should have been deleted", wantedIdx)
+	chainService.Start()
+	if err := chainService.Stop(); err != nil {
+		t.Fatalf("Unable to stop chain service: %v", err)
 	}
 
-	if _, ok := v.ExitedValidators[epoch]; ok {
-		t.Errorf("Activated validators mapping for epoch %d still there", epoch)
+	for i, validator := range beaconState.ValidatorRegistry {
+		idx, err := db.ValidatorIndex(validator.Pubkey)
+		if err != nil {
+			t.Fatalf("Could not get validator index: %v", err)
+		}
+		if idx != uint64(i) {
+			t.Errorf("Wanted: %d, got: %d", i, idx)
+		}
+	}
+	pending := beaconState.ValidatorRegistry[len(beaconState.ValidatorRegistry)-1]
+	if helpers.IsActiveValidator(pending, helpers.CurrentEpoch(beaconState)) {
+		t.Error("Indexed validator should not be active before its activation epoch")
 	}
 }
 
-func TestSaveValidatorIdx_SaveRetrieveWorks(t *testing.T) {
+func TestDeleteValidatorIdx_DeleteWorks(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
-	epoch := uint64(1)
-	v.ActivatedValidators[epoch] = []uint64{0, 1, 2}
-	var validators []*pb.Validator
-	for i := 0; i < 3; i++ {
-		pubKeyBuf := make([]byte, params.BeaconConfig().BLSPubkeyLength)
-		binary.PutUvarint(pubKeyBuf, uint64(i))
-		validators = append(validators, &pb.Validator{
-			Pubkey: pubKeyBuf,
-		})
+	ctx := context.Background()
+	chainService := setupBeaconChain(t, false, db, true, nil)
+
+	deposits, _ := setupInitialDeposits(t, 10)
+	if err := db.InitializeState(uint64(time.Now().Unix()), deposits, &pb.Eth1Data{}); err != nil {
+		t.Fatalf("Could not initialize beacon state to disk: %v", err)
 	}
-	state := &pb.BeaconState{
-		ValidatorRegistry: validators,
-		Slot:              epoch * params.BeaconConfig().SlotsPerEpoch,
+	beaconState, err := db.State(ctx)
+	if err != nil {
+		t.Fatalf("Could not fetch beacon state: %v", err)
 	}
-	chainService := setupBeaconChain(t, false, db, true, nil)
-	if err := chainService.saveValidatorIdx(state); err != nil {
-		t.Fatalf("Could not save validator idx: %v", err)
+	setupGenesisBlock(t, chainService, beaconState)
+	// A validator which is not in the registry of the head state, e.g. one of an
+	// abandoned branch, is dropped from the index on startup.
+	stale := make([]byte, params.BeaconConfig().BLSPubkeyLength)
+	copy(stale, "stale")
+	if err := db.SaveValidatorIndex(stale, len(beaconState.ValidatorRegistry)); err != nil {
+		t.Fatalf("Could not save validator index: %v", err)
 	}
 
-	wantedIdx := uint64(2)
-	idx, err := chainService.beaconDB.ValidatorIndex(validators[wantedIdx].Pubkey)
+	chainService.Start()
+	if err := chainService.Stop(); err != nil {
+		t.Fatalf("Unable to stop chain service: %v", err)
+	}
+
+	if db.HasValidator(stale) {
+		t.Error("Validator missing from the head state should have been deleted")
+	}
+	wantedIdx := uint64(1)
+	idx, err := db.ValidatorIndex(beaconState.ValidatorRegistry[wantedIdx].Pubkey)
 	if err != nil {
 		t.Fatalf("Could not get validator index: %v", err)
 	}
 	if wantedIdx != idx {
 		t.Errorf("Wanted: %d, got: %d", wantedIdx, idx)
 	}
-
-	if _, ok := v.ActivatedValidators[epoch]; ok {
-		t.Errorf("Activated validators mapping for epoch %d still there", epoch)
-	}
 }
 
 func TestAttestationTargets_RetrieveWorks(t *testing.T) {
@@ -842,10 +988,85 @@ func TestAttestationTargets_RetrieveWorks(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Could not get attestation targets: %v", err)
 	}
-	if attestationTargets[0].validatorIndex != 0 {
-		t.Errorf("Wanted validator index 0, got %d", attestationTargets[0].validatorIndex)
+	if _, ok := attestationTargets[0]; !ok {
+		t.Fatal("Wanted an attestation target for validator index 0")
 	}
-	if attestationTargets[0].block.Slot != block.Slot {
-		t.Errorf("Wanted attested slot %d, got %d", block.Slot, attestationTargets[0].block.Slot)
+	if attestationTargets[0] != blockRoot {
+		t.Errorf("Wanted attested block root %#x, got %#x", blockRoot, attestationTargets[0])
+	}
+}
+
+func TestInitForkChoiceStore_LoadsSideBranches(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	chainService := setupBeaconChain(t, false, db, true, nil)
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	finalized := &pb.BeaconBlock{Slot: genesisSlot}
+	roots := make(map[string][32]byte)
+	save := func(name string, block *pb.BeaconBlock) {
+		if err := db.SaveBlock(block); err != nil {
+			t.Fatal(err)
+		}
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		roots[name] = root
+	}
+	save("finalized", finalized)
+	finalizedRoot := roots["finalized"]
+	save("head parent", &pb.BeaconBlock{Slot: genesisSlot + 1, ParentRootHash32: finalizedRoot[:]})
+	headParentRoot := roots["head parent"]
+	head := &pb.BeaconBlock{Slot: genesisSlot + 2, ParentRootHash32: headParentRoot[:]}
+	save("head", head)
+	save("side branch", &pb.BeaconBlock{
+		Slot:             genesisSlot + 1,
+		ParentRootHash32: finalizedRoot[:],
+		StateRootHash32:  []byte{'a'},
+	})
+
+	if err := chainService.initForkChoiceStore(head, &pb.BeaconState{
+		FinalizedEpoch: params.BeaconConfig().GenesisEpoch,
+	}); err != nil {
+		t.Fatalf("Could not initialize fork-choice store: %v", err)
+	}
+	for name, root := range roots {
+		if !chainService.forkChoiceStore.hasBlock(root) {
+			t.Errorf("Expected %s block to be in the fork-choice store", name)
+		}
+	}
+}
+
+func TestNotifyCheckpointUpdate_OnlyOnChange(t *testing.T) {
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	chainService := &ChainService{
+		checkpointFeed: new(event.Feed),
+		justifiedEpoch: genesisEpoch,
+		finalizedEpoch: genesisEpoch,
+	}
+	updateChan := make(chan *CheckpointUpdate, 2)
+	sub := chainService.CheckpointFeed().Subscribe(updateChan)
+	defer sub.Unsubscribe()
+
+	chainService.notifyCheckpointUpdate(&pb.BeaconState{
+		JustifiedEpoch: genesisEpoch,
+		FinalizedEpoch: genesisEpoch,
+	})
+	chainService.notifyCheckpointUpdate(&pb.BeaconState{
+		JustifiedEpoch: genesisEpoch + 1,
+		FinalizedEpoch: genesisEpoch,
+	})
+	if len(updateChan) != 1 {
+		t.Fatalf("Expected 1 checkpoint update, received %d", len(updateChan))
+	}
+	want := &CheckpointUpdate{
+		OldJustifiedEpoch: genesisEpoch,
+		JustifiedEpoch:    genesisEpoch + 1,
+		OldFinalizedEpoch: genesisEpoch,
+		FinalizedEpoch:    genesisEpoch,
+	}
+	if update := <-updateChan; *update != *want {
+		t.Errorf("Expected checkpoint update %v, received %v", want, update)
 	}
 }
@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"go.opencensus.io/trace"
 )
 
 // GenerateStateFromSlot generates state from the last finalized epoch till the specified slot.
@@ -70,3 +74,154 @@ func GenerateStateFromSlot(ctx context.Context, db *db.BeaconDB, slot uint64) (*
 
 	return fState, nil
 }
+
+// StateGenerator regenerates the state at any slot of any branch known to the DB by
+// replaying blocks on top of the closest stored state. Regenerated states are kept in
+// a bounded LRU cache, so that nearby queries replay from them instead of the DB.
+type StateGenerator struct {
+	beaconDB *db.BeaconDB
+	cache    *lru.Cache
+}
+
+// stateKey identifies the state at a slot on the branch of a block.
+type stateKey struct {
+	root [32]byte
+	slot uint64
+}
+
+// NewStateGenerator creates a state generator which caches at most cacheSize
+// regenerated states.
+func NewStateGenerator(beaconDB *db.BeaconDB, cacheSize int) (*StateGenerator, error) {
+	cache, err := lru.New(cacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("could not create state cache: %v", err)
+	}
+	return &StateGenerator{
+		beaconDB: beaconDB,
+		cache:    cache,
+	}, nil
+}
+
+// StateAtSlot returns the state at the given slot on the branch of the block with the given
+// root. This is the post-state of the latest ancestor of the block at or before the slot,
+// advanced through the empty slots after it. Blocks in the DB were verified when they were
+// first received, so their signatures are not checked again while replaying.
+func (g *StateGenerator) StateAtSlot(ctx context.Context, root [32]byte, slot uint64) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.stategenerator.StateAtSlot")
+	defer span.End()
+
+	block, err := g.beaconDB.Block(root)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve block: %v", err)
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block %#x not found", root)
+	}
+	for block.Slot > slot {
+		root = bytesutil.ToBytes32(block.ParentRootHash32)
+		block, err = g.beaconDB.Block(root)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve block: %v", err)
+		}
+		if block == nil {
+			return nil, fmt.Errorf("branch has no known block at or before slot %d", slot)
+		}
+	}
+	if cached, ok := g.cache.Get(stateKey{root: root, slot: slot}); ok {
+		return proto.Clone(cached.(*pb.BeaconState)).(*pb.BeaconState), nil
+	}
+
+	beaconState, branch, branchRoots, err := g.closestState(root, block)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(branch) - 1; i >= 0; i-- {
+		beaconState, err = replayBlock(ctx, beaconState, branch[i], branchRoots[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	g.cache.Add(stateKey{root: root, slot: block.Slot}, proto.Clone(beaconState))
+	if slot == block.Slot {
+		return beaconState, nil
+	}
+
+	beaconState, err = advanceState(ctx, beaconState, root, slot)
+	if err != nil {
+		return nil, err
+	}
+	g.cache.Add(stateKey{root: root, slot: slot}, proto.Clone(beaconState))
+	return beaconState, nil
+}
+
+// closestState walks back from the given block until it finds a block whose post-state is
+// cached or stored in the DB. It returns a copy of that state along with the blocks after
+// it which need to be replayed, in descending slot order, and the roots of their parents.
+func (g *StateGenerator) closestState(root [32]byte, block *pb.BeaconBlock) (
+	*pb.BeaconState, []*pb.BeaconBlock, [][32]byte, error) {
+	var branch []*pb.BeaconBlock
+	var parentRoots [][32]byte
+	for {
+		if cached, ok := g.cache.Get(stateKey{root: root, slot: block.Slot}); ok {
+			return proto.Clone(cached.(*pb.BeaconState)).(*pb.BeaconState), branch, parentRoots, nil
+		}
+		beaconState, err := g.beaconDB.StateByRoot(root)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("could not retrieve state: %v", err)
+		}
+		if beaconState != nil {
+			return beaconState, branch, parentRoots, nil
+		}
+
+		branch = append(branch, block)
+		root = bytesutil.ToBytes32(block.ParentRootHash32)
+		parentRoots = append(parentRoots, root)
+		block, err = g.beaconDB.Block(root)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("could not retrieve block: %v", err)
+		}
+		if block == nil {
+			return nil, nil, nil, fmt.Errorf("no stored state found on the branch of block %#x", root)
+		}
+	}
+}
+
+// replayBlock advances the state through the empty slots before the given block and then
+// runs the state transition of the block itself.
+func replayBlock(ctx context.Context, beaconState *pb.BeaconState, block *pb.BeaconBlock,
+	parentRoot [32]byte) (*pb.BeaconState, error) {
+	beaconState, err := advanceState(ctx, beaconState, parentRoot, block.Slot-1)
+	if err != nil {
+		return nil, err
+	}
+	beaconState, err = state.ExecuteStateTransition(
+		ctx,
+		beaconState,
+		block,
+		parentRoot,
+		false, /* no sig verify */
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not execute state transition with block %v", err)
+	}
+	return beaconState, nil
+}
+
+// advanceState runs empty slot transitions until the state reaches the given slot.
+func advanceState(ctx context.Context, beaconState *pb.BeaconState, parentRoot [32]byte,
+	slot uint64) (*pb.BeaconState, error) {
+	var err error
+	for beaconState.Slot < slot {
+		beaconState, err = state.ExecuteStateTransition(
+			ctx,
+			beaconState,
+			nil,
+			parentRoot,
+			false, /* no sig verify */
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not execute state transition without block %v", err)
+		}
+	}
+	return beaconState, nil
+}
@@ -0,0 +1,39 @@
+package stategenerator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestStateAtSlot_CachesReturnedStates(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+
+	block := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot}
+	if err := beaconDB.SaveBlock(block); err != nil {
+		t.Fatalf("Unable to save block %v", err)
+	}
+	blockRoot, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := beaconDB.SaveStateForBlock(blockRoot, &pb.BeaconState{Slot: block.Slot}); err != nil {
+		t.Fatalf("Unable to save state: %v", err)
+	}
+
+	generator, err := NewStateGenerator(beaconDB, 8)
+	if err != nil {
+		t.Fatalf("Could not create state generator: %v", err)
+	}
+	if _, err := generator.StateAtSlot(context.Background(), blockRoot, block.Slot); err != nil {
+		t.Fatalf("Unable to generate state: %v", err)
+	}
+	if !generator.cache.Contains(stateKey{root: blockRoot, slot: block.Slot}) {
+		t.Error("Expected the regenerated state to be cached")
+	}
+}
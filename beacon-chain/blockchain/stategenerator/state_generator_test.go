@@ -1,12 +1,15 @@
-package stategenerator
+package stategenerator_test
 
 import (
 	"context"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/stategenerator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/chaintest/backend"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -59,7 +62,7 @@ func TestGenerateState_OK(t *testing.T) {
 	}
 
 	slotToGenerate := genesisSlot + 2*(slotLimit)
-	newState, err := GenerateStateFromSlot(context.Background(), beaconDb, slotToGenerate)
+	newState, err := stategenerator.GenerateStateFromSlot(context.Background(), beaconDb, slotToGenerate)
 	if err != nil {
 		t.Fatalf("Unable to generate new state from previous finalized state %v", err)
 	}
@@ -131,7 +134,7 @@ func TestGenerateState_WithNilBlocksOK(t *testing.T) {
 	}
 
 	slotToGenerate := genesisSlot + 2*(slotLimit)
-	newState, err := GenerateStateFromSlot(context.Background(), beaconDb, slotToGenerate)
+	newState, err := stategenerator.GenerateStateFromSlot(context.Background(), beaconDb, slotToGenerate)
 	if err != nil {
 		t.Fatalf("Unable to generate new state from previous finalized state %v", err)
 	}
@@ -145,3 +148,104 @@ func TestGenerateState_WithNilBlocksOK(t *testing.T) {
 		t.Error("generated and saved states are unequal")
 	}
 }
+
+func TestStateAtSlot_ReplaysFromClosestState(t *testing.T) {
+	bd, err := backend.NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	privKeys, err := bd.SetupBackend(100)
+	if err != nil {
+		t.Fatalf("Could not set up backend %v", err)
+	}
+	beaconDb := bd.DB()
+	defer bd.Shutdown()
+	defer db.TeardownDB(beaconDb)
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	// The simulated backend updates the ETH1 data of the state outside of the state
+	// transition for the first block, so the state of that block is the stored snapshot.
+	if err := bd.GenerateBlockAndAdvanceChain(&backend.SimulatedObjects{}, privKeys); err != nil {
+		t.Fatalf("Could not generate block and transition state successfully %v", err)
+	}
+	firstRoot, err := hashutil.HashBeaconBlock(bd.InMemoryBlocks()[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconDb.SetStateStoragePolicy(db.StoreAllStates)
+	if _, err := beaconDb.SaveStateForBlock(firstRoot, proto.Clone(bd.State()).(*pb.BeaconState)); err != nil {
+		t.Fatalf("Unable to save state: %v", err)
+	}
+
+	slotLimit := uint64(10)
+	states := make(map[uint64]*pb.BeaconState)
+	for i := uint64(1); i < slotLimit; i++ {
+		if err := bd.GenerateBlockAndAdvanceChain(&backend.SimulatedObjects{}, privKeys); err != nil {
+			t.Fatalf("Could not generate block and transition state successfully %v for slot %d", err, bd.State().Slot+1)
+		}
+		states[bd.State().Slot] = proto.Clone(bd.State()).(*pb.BeaconState)
+	}
+	blocks := bd.InMemoryBlocks()
+	for _, v := range blocks {
+		if err := beaconDb.SaveBlock(v); err != nil {
+			t.Fatalf("Unable to save block %v", err)
+		}
+	}
+	headRoot, err := hashutil.HashBeaconBlock(blocks[len(blocks)-1])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generator, err := stategenerator.NewStateGenerator(beaconDb, 8)
+	if err != nil {
+		t.Fatalf("Could not create state generator: %v", err)
+	}
+	for _, slot := range []uint64{genesisSlot + 5, genesisSlot + slotLimit} {
+		newState, err := generator.StateAtSlot(context.Background(), headRoot, slot)
+		if err != nil {
+			t.Fatalf("Unable to generate state at slot %d: %v", slot-genesisSlot, err)
+		}
+		if !proto.Equal(newState, states[slot]) {
+			t.Errorf("Generated state at slot %d does not match the state of the chain", slot-genesisSlot)
+		}
+	}
+
+	// The replayed states are cached, so a query for a later slot replays from them.
+	newState, err := generator.StateAtSlot(context.Background(), headRoot, genesisSlot+slotLimit+2)
+	if err != nil {
+		t.Fatalf("Unable to generate state past the head block: %v", err)
+	}
+	if newState.Slot != genesisSlot+slotLimit+2 {
+		t.Errorf("Expected state at slot %d, received %d", slotLimit+2, newState.Slot-genesisSlot)
+	}
+}
+
+func TestStateAtSlot_NoStoredState(t *testing.T) {
+	bd, err := backend.NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	if _, err := bd.SetupBackend(10); err != nil {
+		t.Fatalf("Could not set up backend %v", err)
+	}
+	beaconDb := bd.DB()
+	defer bd.Shutdown()
+	defer db.TeardownDB(beaconDb)
+
+	genesisBlock := bd.InMemoryBlocks()[0]
+	if err := beaconDb.SaveBlock(genesisBlock); err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := hashutil.HashBeaconBlock(genesisBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generator, err := stategenerator.NewStateGenerator(beaconDb, 8)
+	if err != nil {
+		t.Fatalf("Could not create state generator: %v", err)
+	}
+	if _, err := generator.StateAtSlot(context.Background(), genesisRoot, genesisBlock.Slot); err == nil {
+		t.Error("Expected regeneration without any stored state to fail")
+	}
+}
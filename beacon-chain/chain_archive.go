@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/archive"
+	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/node"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+var exportChainCommand = cli.Command{
+	Name:      "export-chain",
+	Usage:     "Export the canonical blocks of the local beacon chain into an archive file",
+	ArgsUsage: "<archive file>",
+	Flags: []cli.Flag{
+		utils.StartSlotFlag,
+		utils.EndSlotFlag,
+		utils.WithStateFlag,
+	},
+	Action: exportChain,
+}
+
+var importChainCommand = cli.Command{
+	Name:      "import-chain",
+	Usage:     "Import the blocks of an archive file into the local beacon chain",
+	ArgsUsage: "<archive file>",
+	Action:    importChain,
+}
+
+func exportChain(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.New("expected the path of the archive file as the only argument")
+	}
+	beaconDB, err := openArchiveDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer beaconDB.Close()
+
+	startSlot := params.BeaconConfig().GenesisSlot + ctx.Uint64(utils.StartSlotFlag.Name)
+	endSlot := uint64(math.MaxUint64)
+	if ctx.IsSet(utils.EndSlotFlag.Name) {
+		endSlot = params.BeaconConfig().GenesisSlot + ctx.Uint64(utils.EndSlotFlag.Name)
+	}
+
+	file, err := os.Create(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("could not create archive file: %v", err)
+	}
+	count, err := archive.Export(context.Background(), file, beaconDB, startSlot, endSlot, ctx.Bool(utils.WithStateFlag.Name))
+	if err != nil {
+		// #nosec G104
+		file.Close()
+		return fmt.Errorf("could not export chain: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("could not close archive file: %v", err)
+	}
+	logrus.WithFields(logrus.Fields{
+		"prefix": "main",
+		"blocks": count,
+		"file":   ctx.Args().First(),
+	}).Info("Exported chain")
+	return nil
+}
+
+func importChain(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.New("expected the path of the archive file as the only argument")
+	}
+	beaconDB, err := openArchiveDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer beaconDB.Close()
+
+	file, err := os.Open(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("could not open archive file: %v", err)
+	}
+	defer file.Close()
+
+	// The services are not started, blocks are processed synchronously by the import.
+	goctx := context.Background()
+	chainService, err := blockchain.NewChainService(goctx, &blockchain.Config{
+		BeaconDB:       beaconDB,
+		AttsService:    attestation.NewAttestationService(goctx, &attestation.Config{BeaconDB: beaconDB}),
+		OpsPoolService: operations.NewOpsPoolService(goctx, &operations.Config{BeaconDB: beaconDB}),
+	})
+	if err != nil {
+		return fmt.Errorf("could not create chain service: %v", err)
+	}
+	count, err := archive.Import(goctx, file, beaconDB, chainService)
+	if err != nil {
+		return fmt.Errorf("could not import chain after %d blocks: %v", count, err)
+	}
+	logrus.WithFields(logrus.Fields{
+		"prefix": "main",
+		"blocks": count,
+		"file":   ctx.Args().First(),
+	}).Info("Imported chain")
+	return nil
+}
+
+// openArchiveDB opens the node DB with the beacon chain parameters selected on the command line.
+func openArchiveDB(ctx *cli.Context) (*db.BeaconDB, error) {
+	if ctx.GlobalBool(utils.DemoConfigFlag.Name) {
+		params.UseDemoBeaconConfig()
+	}
+	return node.OpenDB(ctx)
+}
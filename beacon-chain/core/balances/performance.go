@@ -0,0 +1,116 @@
+package balances
+
+import (
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// Component is a reward or penalty component of the balance change of a validator in an
+// epoch transition.
+type Component int
+
+const (
+	// FFGSourceDelta is the component of the rewards and penalties for the FFG source vote.
+	FFGSourceDelta Component = iota
+	// FFGTargetDelta is the component of the rewards and penalties for the FFG target vote.
+	FFGTargetDelta
+	// ChainHeadDelta is the component of the rewards and penalties for the chain head vote.
+	ChainHeadDelta
+	// InclusionDistanceDelta is the component of the rewards and penalties for the inclusion
+	// delay of the attestations.
+	InclusionDistanceDelta
+	// InactivityPenaltyDelta is the component of the penalties applied to slashed validators
+	// during an inactivity leak.
+	InactivityPenaltyDelta
+	// AttestationInclusionDelta is the component of the rewards of the proposers for including
+	// attestations.
+	AttestationInclusionDelta
+	// CrosslinkDelta is the component of the rewards and penalties for crosslink votes.
+	CrosslinkDelta
+	// SlashingPenaltyDelta is the component of the penalties of slashed validators.
+	SlashingPenaltyDelta
+)
+
+// field returns the field of the performance record of a validator holding the component.
+func (c Component) field(record *pb.ValidatorPerformance) *int64 {
+	switch c {
+	case FFGSourceDelta:
+		return &record.FfgSourceDelta
+	case FFGTargetDelta:
+		return &record.FfgTargetDelta
+	case ChainHeadDelta:
+		return &record.ChainHeadDelta
+	case InclusionDistanceDelta:
+		return &record.InclusionDistanceDelta
+	case InactivityPenaltyDelta:
+		return &record.InactivityPenaltyDelta
+	case AttestationInclusionDelta:
+		return &record.AttestationInclusionDelta
+	case CrosslinkDelta:
+		return &record.CrosslinkDelta
+	case SlashingPenaltyDelta:
+		return &record.SlashingPenaltyDelta
+	default:
+		panic(fmt.Sprintf("unknown balance component %d", c))
+	}
+}
+
+// Tracker attributes the balance changes of the validators in an epoch transition to the
+// reward and penalty components applying them.
+type Tracker struct {
+	performance *pb.EpochPerformance
+	balances    []uint64
+}
+
+// NewTracker starts tracking the balance changes of the validators of the state in the
+// transition at the end of the current epoch.
+func NewTracker(state *pb.BeaconState, currentEpoch uint64) *Tracker {
+	records := make([]*pb.ValidatorPerformance, len(state.ValidatorBalances))
+	for i := range records {
+		records[i] = &pb.ValidatorPerformance{ValidatorIndex: uint64(i)}
+	}
+	return &Tracker{
+		performance: &pb.EpochPerformance{Epoch: currentEpoch, Validators: records},
+		balances:    append([]uint64{}, state.ValidatorBalances...),
+	}
+}
+
+// Record attributes the balance changes since the previous record to the component.
+func (t *Tracker) Record(state *pb.BeaconState, c Component) {
+	for i, record := range t.performance.Validators {
+		if i >= len(state.ValidatorBalances) {
+			break
+		}
+		*c.field(record) += int64(state.ValidatorBalances[i]) - int64(t.balances[i])
+		t.balances[i] = state.ValidatorBalances[i]
+	}
+}
+
+// RecordInclusionDistances records the inclusion distance of the attestations of the
+// given validators, as found in the state.
+func (t *Tracker) RecordInclusionDistances(state *pb.BeaconState, attesterIndices []uint64) error {
+	for _, index := range attesterIndices {
+		if index >= uint64(len(t.performance.Validators)) {
+			continue
+		}
+		distance, err := epoch.InclusionDistance(state, index)
+		if err != nil {
+			return fmt.Errorf("could not get inclusion distance: %v", err)
+		}
+		t.performance.Validators[index].InclusionDistance = distance
+	}
+	return nil
+}
+
+// Performance returns the tracked records, along with the balances of the validators in
+// the state at the end of the transition.
+func (t *Tracker) Performance(state *pb.BeaconState) *pb.EpochPerformance {
+	for i, record := range t.performance.Validators {
+		if i < len(state.ValidatorBalances) {
+			record.Balance = state.ValidatorBalances[i]
+		}
+	}
+	return t.performance
+}
@@ -0,0 +1,62 @@
+package balances
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestTracker_RecordsComponents(t *testing.T) {
+	validatorBalances := make([]uint64, 4)
+	for i := 0; i < len(validatorBalances); i++ {
+		validatorBalances[i] = params.BeaconConfig().MaxDepositAmount
+	}
+	state := &pb.BeaconState{
+		ValidatorRegistry: []*pb.Validator{
+			{ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+			{ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+			{ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+			{ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+		},
+		ValidatorBalances: validatorBalances,
+	}
+	totalBalance := uint64(len(validatorBalances)) * params.BeaconConfig().MaxDepositAmount
+	voted := []uint64{0, 1}
+	votedBalance := uint64(len(voted)) * params.BeaconConfig().MaxDepositAmount
+
+	tracker := NewTracker(state, params.BeaconConfig().GenesisEpoch)
+	state = ExpectedFFGSource(context.Background(), state, voted, votedBalance, totalBalance)
+	tracker.Record(state, FFGSourceDelta)
+	state = ExpectedFFGTarget(context.Background(), state, []uint64{0}, params.BeaconConfig().MaxDepositAmount, totalBalance)
+	tracker.Record(state, FFGTargetDelta)
+	performance := tracker.Performance(state)
+
+	if performance.Epoch != params.BeaconConfig().GenesisEpoch {
+		t.Errorf("Expected epoch %d, received %d", params.BeaconConfig().GenesisEpoch, performance.Epoch)
+	}
+	// The balances after the FFG source rewards are tested in TestFFGSrcRewardsPenalties_AccurateBalances.
+	wantedSource := []int64{286225, 286225, -572450, -572450}
+	for i, record := range performance.Validators {
+		if record.ValidatorIndex != uint64(i) {
+			t.Errorf("Expected record %d to be of validator %d, received %d", i, i, record.ValidatorIndex)
+		}
+		if record.FfgSourceDelta != wantedSource[i] {
+			t.Errorf("Expected FFG source delta %d for validator %d, received %d",
+				wantedSource[i], i, record.FfgSourceDelta)
+		}
+		if record.ChainHeadDelta != 0 {
+			t.Errorf("Expected no chain head delta for validator %d, received %d", i, record.ChainHeadDelta)
+		}
+		delta := int64(record.Balance) - int64(params.BeaconConfig().MaxDepositAmount)
+		if record.FfgSourceDelta+record.FfgTargetDelta != delta {
+			t.Errorf("Expected the components of validator %d to add up to %d, received %d",
+				i, delta, record.FfgSourceDelta+record.FfgTargetDelta)
+		}
+	}
+	if performance.Validators[0].FfgTargetDelta <= 0 || performance.Validators[1].FfgTargetDelta >= 0 {
+		t.Errorf("Expected a FFG target reward for validator 0 and a penalty for validator 1, received %d and %d",
+			performance.Validators[0].FfgTargetDelta, performance.Validators[1].FfgTargetDelta)
+	}
+}
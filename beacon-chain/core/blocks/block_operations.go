@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/stateutils"
 	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/forkutils"
@@ -117,6 +118,16 @@ func ProcessBlockRandao(ctx context.Context, beaconState *pb.BeaconState, block
 // Verify that bls_verify(pubkey=proposer.pubkey, message_hash=hash_tree_root(get_current_epoch(state)),
 //   signature=block.randao_reveal, domain=get_domain(state.fork, get_current_epoch(state), DOMAIN_RANDAO))
 func verifyBlockRandao(beaconState *pb.BeaconState, block *pb.BeaconBlock, proposer *pb.Validator) error {
+	batch := bls.NewSignatureBatch()
+	if err := addBlockRandao(batch, beaconState, block, proposer); err != nil {
+		return err
+	}
+	return batch.Verify()
+}
+
+// addBlockRandao queues the check of the block randao reveal, signed by the proposer
+// over the current epoch, into the given batch.
+func addBlockRandao(batch *bls.SignatureBatch, beaconState *pb.BeaconState, block *pb.BeaconBlock, proposer *pb.Validator) error {
 	pub, err := bls.PublicKeyFromBytes(proposer.Pubkey)
 	if err != nil {
 		return fmt.Errorf("could not deserialize proposer public key: %v", err)
@@ -134,9 +145,190 @@ func verifyBlockRandao(beaconState *pb.BeaconState, block *pb.BeaconBlock, propo
 		"pubkey":   fmt.Sprintf("%#x", proposer.Pubkey),
 		"epochSig": fmt.Sprintf("%#x", sig.Marshal()),
 	}).Info("Verifying randao")
-	if !sig.Verify(buf, pub, domain) {
-		return fmt.Errorf("block randao reveal signature did not verify")
+	batch.Add(sig, pub, buf, domain, "block randao reveal")
+	return nil
+}
+
+// BlockSignatureBatch collects the signature checks of a block into a single batch, so that
+// they can be verified together before the block operations are processed. The given state
+// must be the pre-state of the block, advanced to the block slot.
+//
+// Only the RANDAO reveal is checked for now. Enforcing the signatures of the other block
+// operations changes which blocks are valid, so they are added to the batch separately
+// (TODO(#258)).
+func BlockSignatureBatch(beaconState *pb.BeaconState, block *pb.BeaconBlock) (*bls.SignatureBatch, error) {
+	batch := bls.NewSignatureBatch()
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not get beacon proposer index: %v", err)
+	}
+	proposer := beaconState.ValidatorRegistry[proposerIdx]
+	if err := addBlockRandao(batch, beaconState, block, proposer); err != nil {
+		return nil, fmt.Errorf("could not add block randao: %v", err)
+	}
+	return batch, nil
+}
+
+// addProposerSlashing queues the checks of both proposals of a proposer slashing, each signed
+// by the slashed proposer over the proposal data, into the given batch.
+func addProposerSlashing(batch *bls.SignatureBatch, beaconState *pb.BeaconState, slashing *pb.ProposerSlashing, description string) error {
+	if slashing.ProposerIndex >= uint64(len(beaconState.ValidatorRegistry)) {
+		return fmt.Errorf("proposer index %d out of range", slashing.ProposerIndex)
+	}
+	pub, err := bls.PublicKeyFromBytes(beaconState.ValidatorRegistry[slashing.ProposerIndex].Pubkey)
+	if err != nil {
+		return fmt.Errorf("could not deserialize proposer public key: %v", err)
+	}
+	proposals := []struct {
+		data      *pb.ProposalSignedData
+		signature []byte
+	}{
+		{data: slashing.ProposalData_1, signature: slashing.ProposalSignature_1},
+		{data: slashing.ProposalData_2, signature: slashing.ProposalSignature_2},
+	}
+	for i, proposal := range proposals {
+		if proposal.data == nil {
+			return errors.New("missing proposal data")
+		}
+		msg, err := hashutil.HashProto(proposal.data)
+		if err != nil {
+			return fmt.Errorf("could not hash proposal data: %v", err)
+		}
+		sig, err := bls.SignatureFromBytes(proposal.signature)
+		if err != nil {
+			return fmt.Errorf("could not deserialize proposal signature: %v", err)
+		}
+		domain := forkutils.DomainVersion(
+			beaconState.Fork,
+			helpers.SlotToEpoch(proposal.data.Slot),
+			params.BeaconConfig().DomainProposal,
+		)
+		batch.Add(sig, pub, msg[:], domain, fmt.Sprintf("%s proposal %d", description, i+1))
+	}
+	return nil
+}
+
+// addSlashableAttestation queues the check of the aggregate signature of a slashable
+// attestation into the given batch. The custody bitfield is indexed by the position of
+// the validators in the validator indices of the attestation.
+func addSlashableAttestation(batch *bls.SignatureBatch, beaconState *pb.BeaconState, att *pb.SlashableAttestation, description string) error {
+	if att == nil || att.Data == nil {
+		return errors.New("missing slashable attestation data")
+	}
+	var custodyBit0Indices, custodyBit1Indices []uint64
+	for i, idx := range att.ValidatorIndices {
+		custodyBit, err := bitutil.CheckBit(att.CustodyBitfield, i)
+		if err != nil {
+			return fmt.Errorf("could not get custody bit: %v", err)
+		}
+		if custodyBit {
+			custodyBit1Indices = append(custodyBit1Indices, idx)
+		} else {
+			custodyBit0Indices = append(custodyBit0Indices, idx)
+		}
+	}
+	return addCustodyBitSignature(batch, beaconState, att.Data, att.AggregateSignature, custodyBit0Indices, custodyBit1Indices, description)
+}
+
+// addCustodyBitSignature queues the check of an aggregate signature over attestation data,
+// where each validator signed the data along with its custody bit:
+//   bls_verify_multiple(
+//     pubkeys=[
+//       bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_0_indices]),
+//       bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_1_indices]),
+//     ],
+//     message_hashes=[
+//       hash_tree_root(AttestationDataAndCustodyBit(data=data, custody_bit=0b0)),
+//       hash_tree_root(AttestationDataAndCustodyBit(data=data, custody_bit=0b1)),
+//     ],
+//     signature=aggregate_signature,
+//     domain=get_domain(state.fork, slot_to_epoch(data.slot), DOMAIN_ATTESTATION),
+//   )
+// A custody bit without any validator is left out of the check.
+func addCustodyBitSignature(
+	batch *bls.SignatureBatch,
+	beaconState *pb.BeaconState,
+	data *pb.AttestationData,
+	signature []byte,
+	custodyBit0Indices []uint64,
+	custodyBit1Indices []uint64,
+	description string,
+) error {
+	var pubKeys []*bls.PublicKey
+	var msgs [][]byte
+	for custodyBit, indices := range [][]uint64{custodyBit0Indices, custodyBit1Indices} {
+		if len(indices) == 0 {
+			continue
+		}
+		pub, err := aggregatePublicKeys(beaconState, indices)
+		if err != nil {
+			return err
+		}
+		msg, err := hashutil.HashProto(&pb.AttestationDataAndCustodyBit{
+			Data:       data,
+			CustodyBit: custodyBit == 1,
+		})
+		if err != nil {
+			return fmt.Errorf("could not hash attestation data: %v", err)
+		}
+		pubKeys = append(pubKeys, pub)
+		msgs = append(msgs, msg[:])
 	}
+	if len(pubKeys) == 0 {
+		return errors.New("no participating validators")
+	}
+	sig, err := bls.SignatureFromBytes(signature)
+	if err != nil {
+		return fmt.Errorf("could not deserialize aggregate signature: %v", err)
+	}
+	domain := forkutils.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(data.Slot), params.BeaconConfig().DomainAttestation)
+	batch.AddMultiple(sig, pubKeys, msgs, domain, description)
+	return nil
+}
+
+// aggregatePublicKeys sums up the public keys of the validators with the given indices.
+func aggregatePublicKeys(beaconState *pb.BeaconState, indices []uint64) (*bls.PublicKey, error) {
+	var aggregate *bls.PublicKey
+	for _, idx := range indices {
+		if idx >= uint64(len(beaconState.ValidatorRegistry)) {
+			return nil, fmt.Errorf("validator index %d out of range", idx)
+		}
+		pub, err := bls.PublicKeyFromBytes(beaconState.ValidatorRegistry[idx].Pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("could not deserialize public key of validator %d: %v", idx, err)
+		}
+		if aggregate == nil {
+			aggregate = pub
+		} else {
+			aggregate = aggregate.Aggregate(pub)
+		}
+	}
+	return aggregate, nil
+}
+
+// addExit queues the check of a voluntary exit, signed by the exiting validator over the exit
+// with an empty signature, into the given batch.
+func addExit(batch *bls.SignatureBatch, beaconState *pb.BeaconState, exit *pb.VoluntaryExit, description string) error {
+	if exit.ValidatorIndex >= uint64(len(beaconState.ValidatorRegistry)) {
+		return fmt.Errorf("validator index %d out of range", exit.ValidatorIndex)
+	}
+	pub, err := bls.PublicKeyFromBytes(beaconState.ValidatorRegistry[exit.ValidatorIndex].Pubkey)
+	if err != nil {
+		return fmt.Errorf("could not deserialize validator public key: %v", err)
+	}
+	msg, err := hashutil.HashProto(&pb.VoluntaryExit{
+		Epoch:          exit.Epoch,
+		ValidatorIndex: exit.ValidatorIndex,
+	})
+	if err != nil {
+		return fmt.Errorf("could not hash exit: %v", err)
+	}
+	sig, err := bls.SignatureFromBytes(exit.Signature)
+	if err != nil {
+		return fmt.Errorf("could not deserialize exit signature: %v", err)
+	}
+	domain := forkutils.DomainVersion(beaconState.Fork, exit.Epoch, params.BeaconConfig().DomainExit)
+	batch.Add(sig, pub, msg[:], domain, description)
 	return nil
 }
 
@@ -180,7 +372,7 @@ func ProcessProposerSlashings(
 	}
 	var err error
 	for idx, slashing := range body.ProposerSlashings {
-		if err = verifyProposerSlashing(slashing, verifySignatures); err != nil {
+		if err = verifyProposerSlashing(beaconState, slashing, verifySignatures); err != nil {
 			return nil, fmt.Errorf("could not verify proposer slashing #%d: %v", idx, err)
 		}
 		proposer := registry[slashing.ProposerIndex]
@@ -196,6 +388,7 @@ func ProcessProposerSlashings(
 }
 
 func verifyProposerSlashing(
+	beaconState *pb.BeaconState,
 	slashing *pb.ProposerSlashing,
 	verifySignatures bool,
 ) error {
@@ -223,12 +416,31 @@ func verifyProposerSlashing(
 		return fmt.Errorf("slashing proposal data block roots do not match: %#x, %#x", root1, root2)
 	}
 	if verifySignatures {
-		// TODO(#258): Verify BLS according to the specification in the "Proposer Slashings"
-		return nil
+		batch := bls.NewSignatureBatch()
+		if err := addProposerSlashing(batch, beaconState, slashing, "proposer slashing"); err != nil {
+			return err
+		}
+		return batch.Verify()
 	}
 	return nil
 }
 
+// VerifyProposerSlashing checks that a proposer slashing received outside of a block could be
+// processed in a block on top of the given state.
+func VerifyProposerSlashing(beaconState *pb.BeaconState, slashing *pb.ProposerSlashing) error {
+	if slashing.ProposalData_1 == nil || slashing.ProposalData_2 == nil {
+		return errors.New("missing proposal data")
+	}
+	if slashing.ProposerIndex >= uint64(len(beaconState.ValidatorRegistry)) {
+		return fmt.Errorf("proposer index %d out of range", slashing.ProposerIndex)
+	}
+	proposer := beaconState.ValidatorRegistry[slashing.ProposerIndex]
+	if proposer.SlashedEpoch <= helpers.CurrentEpoch(beaconState) {
+		return fmt.Errorf("proposer index %d is already slashed", slashing.ProposerIndex)
+	}
+	return verifyProposerSlashing(beaconState, slashing, true /* verify signatures */)
+}
+
 // ProcessAttesterSlashings is one of the operations performed
 // on each processed beacon block to slash attesters based on
 // Casper FFG slashing conditions if any slashable events occurred.
@@ -268,7 +480,7 @@ func ProcessAttesterSlashings(
 		)
 	}
 	for idx, slashing := range body.AttesterSlashings {
-		if err := verifyAttesterSlashing(slashing, verifySignatures); err != nil {
+		if err := verifyAttesterSlashing(beaconState, slashing, verifySignatures); err != nil {
 			return nil, fmt.Errorf("could not verify attester slashing #%d: %v", idx, err)
 		}
 		slashableIndices, err := attesterSlashableIndices(beaconState, slashing)
@@ -286,7 +498,7 @@ func ProcessAttesterSlashings(
 	return beaconState, nil
 }
 
-func verifyAttesterSlashing(slashing *pb.AttesterSlashing, verifySignatures bool) error {
+func verifyAttesterSlashing(beaconState *pb.BeaconState, slashing *pb.AttesterSlashing, verifySignatures bool) error {
 	slashableAttestation1 := slashing.SlashableAttestation_1
 	slashableAttestation2 := slashing.SlashableAttestation_2
 	data1 := slashableAttestation1.Data
@@ -308,10 +520,10 @@ func verifyAttesterSlashing(slashing *pb.AttesterSlashing, verifySignatures bool
 	if !(isSameTarget || isSurroundVote(data1, data2)) {
 		return errors.New("attester slashing is not a double vote nor surround vote")
 	}
-	if err := verifySlashableAttestation(slashableAttestation1, verifySignatures); err != nil {
+	if err := verifySlashableAttestation(beaconState, slashableAttestation1, verifySignatures); err != nil {
 		return fmt.Errorf("could not verify attester slashable attestation data 1: %v", err)
 	}
-	if err := verifySlashableAttestation(slashableAttestation2, verifySignatures); err != nil {
+	if err := verifySlashableAttestation(beaconState, slashableAttestation2, verifySignatures); err != nil {
 		return fmt.Errorf("could not verify attester slashable attestation data 2: %v", err)
 	}
 	return nil
@@ -340,7 +552,27 @@ func attesterSlashableIndices(beaconState *pb.BeaconState, slashing *pb.Attester
 	return slashableIndices, nil
 }
 
-func verifySlashableAttestation(att *pb.SlashableAttestation, verifySignatures bool) error {
+// VerifyAttesterSlashing checks that an attester slashing received outside of a block could be
+// processed in a block on top of the given state.
+func VerifyAttesterSlashing(beaconState *pb.BeaconState, slashing *pb.AttesterSlashing) error {
+	for _, att := range []*pb.SlashableAttestation{slashing.SlashableAttestation_1, slashing.SlashableAttestation_2} {
+		if att == nil || att.Data == nil {
+			return errors.New("missing slashable attestation data")
+		}
+		for _, idx := range att.ValidatorIndices {
+			if idx >= uint64(len(beaconState.ValidatorRegistry)) {
+				return fmt.Errorf("validator index %d out of range", idx)
+			}
+		}
+	}
+	if err := verifyAttesterSlashing(beaconState, slashing, true /* verify signatures */); err != nil {
+		return err
+	}
+	_, err := attesterSlashableIndices(beaconState, slashing)
+	return err
+}
+
+func verifySlashableAttestation(beaconState *pb.BeaconState, att *pb.SlashableAttestation, verifySignatures bool) error {
 	emptyCustody := make([]byte, len(att.CustodyBitfield))
 	if bytes.Equal(att.CustodyBitfield, emptyCustody) {
 		return errors.New("custody bit field can't all be 0s")
@@ -368,8 +600,11 @@ func verifySlashableAttestation(att *pb.SlashableAttestation, verifySignatures b
 	}
 
 	if verifySignatures {
-		// TODO(#258): Implement BLS verify multiple.
-		return nil
+		batch := bls.NewSignatureBatch()
+		if err := addSlashableAttestation(batch, beaconState, att, "slashable attestation"); err != nil {
+			return err
+		}
+		return batch.Verify()
 	}
 	return nil
 }
@@ -687,6 +922,15 @@ func ProcessValidatorExits(
 	return beaconState, nil
 }
 
+// VerifyExit checks that a voluntary exit received outside of a block could be processed in a
+// block on top of the given state.
+func VerifyExit(beaconState *pb.BeaconState, exit *pb.VoluntaryExit) error {
+	if exit.ValidatorIndex >= uint64(len(beaconState.ValidatorRegistry)) {
+		return fmt.Errorf("validator index %d out of range", exit.ValidatorIndex)
+	}
+	return verifyExit(beaconState, exit, true /* verify signatures */)
+}
+
 func verifyExit(beaconState *pb.BeaconState, exit *pb.VoluntaryExit, verifySignatures bool) error {
 	validator := beaconState.ValidatorRegistry[exit.ValidatorIndex]
 	currentEpoch := helpers.CurrentEpoch(beaconState)
@@ -706,13 +950,11 @@ func verifyExit(beaconState *pb.BeaconState, exit *pb.VoluntaryExit, verifySigna
 		)
 	}
 	if verifySignatures {
-		// TODO(#258): Verify using BLS signature verification below:
-		// Let exit_message = hash_tree_root(
-		//   Exit(epoch=exit.epoch, validator_index=exit.validator_index, signature=EMPTY_SIGNATURE)
-		// )
-		// Verify that bls_verify(pubkey=validator.pubkey, message_hash=exit_message,
-		//   signature=exit.signature, domain=get_domain(state.fork, exit.epoch, DOMAIN_EXIT)).
-		return nil
+		batch := bls.NewSignatureBatch()
+		if err := addExit(batch, beaconState, exit, "voluntary exit"); err != nil {
+			return err
+		}
+		return batch.Verify()
 	}
 	return nil
 }
@@ -17,6 +17,7 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/forkutils"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/ssz"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
@@ -77,6 +78,44 @@ func TestProcessBlockRandao_IncorrectProposerFailsVerification(t *testing.T) {
 	}
 }
 
+func TestBlockSignatureBatch_IncorrectRandaoFailsVerification(t *testing.T) {
+	deposits, privKeys := setupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, params.BeaconConfig().GenesisSlot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	epoch := helpers.SlotToEpoch(params.BeaconConfig().GenesisSlot)
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, epoch)
+	domain := forkutils.DomainVersion(beaconState.Fork, epoch, params.BeaconConfig().DomainRandao)
+
+	block := &pb.BeaconBlock{
+		RandaoReveal: privKeys[proposerIdx].Sign(buf, domain).Marshal(),
+	}
+	batch, err := blocks.BlockSignatureBatch(beaconState, block)
+	if err != nil {
+		t.Fatalf("Could not collect block signatures: %v", err)
+	}
+	if err := batch.Verify(); err != nil {
+		t.Errorf("Expected block signatures to verify, received %v", err)
+	}
+
+	// We make the previous validator's index sign the message instead of the proposer.
+	block.RandaoReveal = privKeys[proposerIdx-1].Sign(buf, domain).Marshal()
+	batch, err = blocks.BlockSignatureBatch(beaconState, block)
+	if err != nil {
+		t.Fatalf("Could not collect block signatures: %v", err)
+	}
+	want := "block randao reveal signature did not verify"
+	if err := batch.Verify(); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %v, received %v", want, err)
+	}
+}
+
 func TestProcessBlockRandao_SignatureVerifiesAndUpdatesLatestStateMixes(t *testing.T) {
 	deposits, privKeys := setupInitialDeposits(t, 100)
 	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
@@ -680,6 +719,142 @@ func TestProcessAttesterSlashings_AppliesCorrectStatus(t *testing.T) {
 	}
 }
 
+// signedProposerSlashing creates a proposer slashing of the given proposer at the slot of the
+// given state, with both proposals signed by the proposer.
+func signedProposerSlashing(t *testing.T, beaconState *pb.BeaconState, privKeys []*bls.SecretKey, proposerIdx uint64) *pb.ProposerSlashing {
+	proposal := &pb.ProposalSignedData{Slot: beaconState.Slot, BlockRootHash32: []byte{1}}
+	root, err := hashutil.HashProto(proposal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutils.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(proposal.Slot), params.BeaconConfig().DomainProposal)
+	signature := privKeys[proposerIdx].Sign(root[:], domain).Marshal()
+	return &pb.ProposerSlashing{
+		ProposerIndex:       proposerIdx,
+		ProposalData_1:      proposal,
+		ProposalSignature_1: signature,
+		ProposalData_2:      proposal,
+		ProposalSignature_2: signature,
+	}
+}
+
+// signSlashableAttestation sets the aggregate signature of a slashable attestation whose
+// custody bits are all set, signed by each of its validators.
+func signSlashableAttestation(t *testing.T, beaconState *pb.BeaconState, privKeys []*bls.SecretKey, att *pb.SlashableAttestation) {
+	root, err := hashutil.HashProto(&pb.AttestationDataAndCustodyBit{Data: att.Data, CustodyBit: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutils.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(att.Data.Slot), params.BeaconConfig().DomainAttestation)
+	var sigs []*bls.Signature
+	for _, idx := range att.ValidatorIndices {
+		sigs = append(sigs, privKeys[idx].Sign(root[:], domain))
+	}
+	att.AggregateSignature = bls.AggregateSignatures(sigs).Marshal()
+}
+
+func TestVerifyProposerSlashing_ChecksProposer(t *testing.T) {
+	deposits, privKeys := setupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState.ValidatorRegistry[1].SlashedEpoch = params.BeaconConfig().GenesisEpoch
+	if err := blocks.VerifyProposerSlashing(beaconState, signedProposerSlashing(t, beaconState, privKeys, 0)); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	want := "proposer index 1 is already slashed"
+	if err := blocks.VerifyProposerSlashing(beaconState, signedProposerSlashing(t, beaconState, privKeys, 1)); err == nil || err.Error() != want {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	slashing := signedProposerSlashing(t, beaconState, privKeys, 0)
+	slashing.ProposerIndex = 100
+	want = "proposer index 100 out of range"
+	if err := blocks.VerifyProposerSlashing(beaconState, slashing); err == nil || err.Error() != want {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestVerifyProposerSlashing_IncorrectSignatureFailsVerification(t *testing.T) {
+	deposits, privKeys := setupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// We make the next validator sign the proposals instead of the slashed proposer.
+	slashing := signedProposerSlashing(t, beaconState, privKeys, 1)
+	slashing.ProposerIndex = 0
+	want := "proposer slashing proposal 1 signature did not verify"
+	if err := blocks.VerifyProposerSlashing(beaconState, slashing); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestVerifyAttesterSlashing_ChecksValidatorIndices(t *testing.T) {
+	deposits, privKeys := setupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slashing := &pb.AttesterSlashing{
+		SlashableAttestation_1: &pb.SlashableAttestation{
+			Data:             &pb.AttestationData{Slot: params.BeaconConfig().GenesisSlot, JustifiedEpoch: 5},
+			ValidatorIndices: []uint64{1, 2},
+			CustodyBitfield:  []byte{0x03},
+		},
+		SlashableAttestation_2: &pb.SlashableAttestation{
+			Data:             &pb.AttestationData{Slot: params.BeaconConfig().GenesisSlot, JustifiedEpoch: 4},
+			ValidatorIndices: []uint64{2, 3},
+			CustodyBitfield:  []byte{0x03},
+		},
+	}
+	signSlashableAttestation(t, beaconState, privKeys, slashing.SlashableAttestation_1)
+	signSlashableAttestation(t, beaconState, privKeys, slashing.SlashableAttestation_2)
+	if err := blocks.VerifyAttesterSlashing(beaconState, slashing); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	slashing.SlashableAttestation_2.ValidatorIndices = []uint64{2, 100}
+	want := "validator index 100 out of range"
+	if err := blocks.VerifyAttesterSlashing(beaconState, slashing); err == nil || err.Error() != want {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	slashing.SlashableAttestation_2.ValidatorIndices = []uint64{0, 3}
+	signSlashableAttestation(t, beaconState, privKeys, slashing.SlashableAttestation_2)
+	want = "expected a non-empty list of slashable indices"
+	if err := blocks.VerifyAttesterSlashing(beaconState, slashing); err == nil || err.Error() != want {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestVerifyAttesterSlashing_IncorrectSignatureFailsVerification(t *testing.T) {
+	deposits, privKeys := setupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slashing := &pb.AttesterSlashing{
+		SlashableAttestation_1: &pb.SlashableAttestation{
+			Data:             &pb.AttestationData{Slot: params.BeaconConfig().GenesisSlot, JustifiedEpoch: 5},
+			ValidatorIndices: []uint64{1, 2},
+			CustodyBitfield:  []byte{0x03},
+		},
+		SlashableAttestation_2: &pb.SlashableAttestation{
+			Data:             &pb.AttestationData{Slot: params.BeaconConfig().GenesisSlot, JustifiedEpoch: 4},
+			ValidatorIndices: []uint64{2, 3},
+			CustodyBitfield:  []byte{0x03},
+		},
+	}
+	signSlashableAttestation(t, beaconState, privKeys, slashing.SlashableAttestation_1)
+	// The second slashable attestation carries the signature of the first one.
+	slashing.SlashableAttestation_2.AggregateSignature = slashing.SlashableAttestation_1.AggregateSignature
+	want := "slashable attestation signature did not verify"
+	if err := blocks.VerifyAttesterSlashing(beaconState, slashing); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
 func TestProcessBlockAttestations_ThresholdReached(t *testing.T) {
 	attestations := make([]*pb.Attestation, params.BeaconConfig().MaxAttestations+1)
 	block := &pb.BeaconBlock{
@@ -1509,3 +1684,45 @@ func TestProcessValidatorExits_AppliesCorrectStatus(t *testing.T) {
 		t.Error("Expected validator status to change, remained INITIAL")
 	}
 }
+
+// signedExit creates a voluntary exit of the given validator at the current epoch of the
+// given state, signed by the given key.
+func signedExit(t *testing.T, beaconState *pb.BeaconState, priv *bls.SecretKey, validatorIdx uint64) *pb.VoluntaryExit {
+	exit := &pb.VoluntaryExit{ValidatorIndex: validatorIdx, Epoch: helpers.CurrentEpoch(beaconState)}
+	root, err := hashutil.HashProto(exit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutils.DomainVersion(beaconState.Fork, exit.Epoch, params.BeaconConfig().DomainExit)
+	exit.Signature = priv.Sign(root[:], domain).Marshal()
+	return exit
+}
+
+func TestVerifyExit_ChecksValidatorIndex(t *testing.T) {
+	deposits, privKeys := setupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := blocks.VerifyExit(beaconState, signedExit(t, beaconState, privKeys[0], 0)); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	want := "validator index 100 out of range"
+	if err := blocks.VerifyExit(beaconState, signedExit(t, beaconState, privKeys[0], 100)); err == nil || err.Error() != want {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func TestVerifyExit_IncorrectSignatureFailsVerification(t *testing.T) {
+	deposits, privKeys := setupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// We make the next validator sign the exit instead of the exiting validator.
+	want := "voluntary exit signature did not verify"
+	if err := blocks.VerifyExit(beaconState, signedExit(t, beaconState, privKeys[1], 0)); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
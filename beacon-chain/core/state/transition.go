@@ -40,6 +40,20 @@ func ExecuteStateTransition(
 	headRoot [32]byte,
 	verifySignatures bool,
 ) (*pb.BeaconState, error) {
+	state, _, err := ExecuteStateTransitionWithPerformance(ctx, state, block, headRoot, verifySignatures)
+	return state, err
+}
+
+// ExecuteStateTransitionWithPerformance executes the state transition like ExecuteStateTransition,
+// and also returns the reward and penalty breakdown of the validators if the transition
+// processed an epoch, or nil otherwise.
+func ExecuteStateTransitionWithPerformance(
+	ctx context.Context,
+	state *pb.BeaconState,
+	block *pb.BeaconBlock,
+	headRoot [32]byte,
+	verifySignatures bool,
+) (*pb.BeaconState, *pb.EpochPerformance, error) {
 	var err error
 
 	// Execute per slot transition.
@@ -49,19 +63,20 @@ func ExecuteStateTransition(
 	if block != nil {
 		state, err = ProcessBlock(ctx, state, block, verifySignatures)
 		if err != nil {
-			return nil, fmt.Errorf("could not process block: %v", err)
+			return nil, nil, fmt.Errorf("could not process block: %v", err)
 		}
 	}
 
 	// Execute per epoch transition.
+	var performance *pb.EpochPerformance
 	if e.CanProcessEpoch(state) {
-		state, err = ProcessEpoch(ctx, state)
+		state, performance, err = ProcessEpoch(ctx, state)
 	}
 	if err != nil {
-		return nil, fmt.Errorf("could not process epoch: %v", err)
+		return nil, nil, fmt.Errorf("could not process epoch: %v", err)
 	}
 
-	return state, nil
+	return state, performance, nil
 }
 
 // ProcessSlot happens every slot and focuses on the slot counter and block roots record updates.
@@ -118,8 +133,24 @@ func ProcessBlock(
 	}
 	log.WithField("blockRoot", fmt.Sprintf("%#x", r)).Debugf("Verified block signature")
 
-	// Verify block RANDAO.
-	state, err = b.ProcessBlockRandao(ctx, state, block, verifySignatures)
+	// Verify the signatures of the block operations as a batch, which is a lot cheaper
+	// than verifying each of them while processing the operations below.
+	if verifySignatures {
+		batch, err := b.BlockSignatureBatch(state, block)
+		if err != nil {
+			return nil, fmt.Errorf("could not collect block signatures: %v", err)
+		}
+		if err := batch.Verify(); err != nil {
+			return nil, fmt.Errorf("could not verify block signatures: %v", err)
+		}
+		log.WithFields(logrus.Fields{
+			"blockRoot":  fmt.Sprintf("%#x", r),
+			"signatures": batch.Len(),
+		}).Debug("Verified block signature batch")
+	}
+
+	// Process block RANDAO.
+	state, err = b.ProcessBlockRandao(ctx, state, block, false)
 	if err != nil {
 		return nil, fmt.Errorf("could not verify and process block randao: %v", err)
 	}
@@ -127,18 +158,18 @@ func ProcessBlock(
 
 	// Process ETH1 data.
 	state = b.ProcessEth1DataInBlock(ctx, state, block)
-	state, err = b.ProcessAttesterSlashings(ctx, state, block, verifySignatures)
+	state, err = b.ProcessAttesterSlashings(ctx, state, block, false)
 	if err != nil {
 		return nil, fmt.Errorf("could not verify block attester slashings: %v", err)
 	}
 	log.WithField("blockRoot", fmt.Sprintf("%#x", r)).Debugf("Processed ETH1 data")
 
-	state, err = b.ProcessProposerSlashings(ctx, state, block, verifySignatures)
+	state, err = b.ProcessProposerSlashings(ctx, state, block, false)
 	if err != nil {
 		return nil, fmt.Errorf("could not verify block proposer slashings: %v", err)
 	}
 
-	state, err = b.ProcessBlockAttestations(ctx, state, block, verifySignatures)
+	state, err = b.ProcessBlockAttestations(ctx, state, block, false)
 	if err != nil {
 		return nil, fmt.Errorf("could not process block attestations: %v", err)
 	}
@@ -147,7 +178,7 @@ func ProcessBlock(
 	if err != nil {
 		return nil, fmt.Errorf("could not process block validator deposits: %v", err)
 	}
-	state, err = b.ProcessValidatorExits(ctx, state, block, verifySignatures)
+	state, err = b.ProcessValidatorExits(ctx, state, block, false)
 	if err != nil {
 		return nil, fmt.Errorf("could not process validator exits: %v", err)
 	}
@@ -162,7 +193,8 @@ func ProcessBlock(
 }
 
 // ProcessEpoch describes the per epoch operations that are performed on the
-// beacon state.
+// beacon state. It returns the breakdown of the balance changes it applied to the
+// validators.
 //
 // Spec pseudocode definition:
 // 	 process_candidate_receipt_roots(state)
@@ -173,7 +205,7 @@ func ProcessBlock(
 // 	 process_crosslink_reward_penalties(state)
 // 	 update_validator_registry(state)
 // 	 final_book_keeping(state)
-func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState, error) {
+func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState, *pb.EpochPerformance, error) {
 
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessEpoch")
 	defer span.End()
@@ -192,12 +224,12 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 
 	currentEpochBoundaryAttestations, err := e.CurrentEpochBoundaryAttestations(ctx, state, currentEpochAttestations)
 	if err != nil {
-		return nil, fmt.Errorf("could not get current boundary attestations: %v", err)
+		return nil, nil, fmt.Errorf("could not get current boundary attestations: %v", err)
 	}
 
 	currentBoundaryAttesterIndices, err := v.ValidatorIndices(ctx, state, currentEpochBoundaryAttestations)
 	if err != nil {
-		return nil, fmt.Errorf("could not get current boundary attester indices: %v", err)
+		return nil, nil, fmt.Errorf("could not get current boundary attester indices: %v", err)
 	}
 	log.Infof("Current epoch boundary attester indices: %v", currentBoundaryAttesterIndices)
 
@@ -211,7 +243,7 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 	log.Infof("Number of prev epoch attestations: %d", len(prevEpochAttestations))
 	prevEpochAttesterIndices, err := v.ValidatorIndices(ctx, state, prevEpochAttestations)
 	if err != nil {
-		return nil, fmt.Errorf("could not get prev epoch attester indices: %v", err)
+		return nil, nil, fmt.Errorf("could not get prev epoch attester indices: %v", err)
 	}
 	log.Infof("Previous epoch attester indices: %v", prevEpochAttesterIndices)
 
@@ -221,13 +253,13 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 	// at the start of previous epoch.
 	prevEpochBoundaryAttestations, err := e.PrevEpochBoundaryAttestations(ctx, state, prevEpochAttestations)
 	if err != nil {
-		return nil, fmt.Errorf("could not get prev boundary attestations: %v", err)
+		return nil, nil, fmt.Errorf("could not get prev boundary attestations: %v", err)
 	}
 	log.Infof("Number of prev epoch boundary attestations: %d", len(prevEpochAttestations))
 
 	prevEpochBoundaryAttesterIndices, err := v.ValidatorIndices(ctx, state, prevEpochBoundaryAttestations)
 	if err != nil {
-		return nil, fmt.Errorf("could not get prev boundary attester indices: %v", err)
+		return nil, nil, fmt.Errorf("could not get prev boundary attester indices: %v", err)
 	}
 	log.Infof("Previous epoch boundary attester indices: %v", prevEpochBoundaryAttesterIndices)
 
@@ -237,11 +269,11 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 	// during previous epoch.
 	prevEpochHeadAttestations, err := e.PrevHeadAttestations(ctx, state, prevEpochAttestations)
 	if err != nil {
-		return nil, fmt.Errorf("could not get prev head attestations: %v", err)
+		return nil, nil, fmt.Errorf("could not get prev head attestations: %v", err)
 	}
 	prevEpochHeadAttesterIndices, err := v.ValidatorIndices(ctx, state, prevEpochHeadAttestations)
 	if err != nil {
-		return nil, fmt.Errorf("could not get prev head attester indices: %v", err)
+		return nil, nil, fmt.Errorf("could not get prev head attester indices: %v", err)
 	}
 	prevEpochHeadAttestingBalances := e.TotalBalance(ctx, state, prevEpochHeadAttesterIndices)
 
@@ -267,7 +299,13 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 		currentEpochAttestations,
 		prevEpochAttestations)
 	if err != nil {
-		return nil, fmt.Errorf("could not process crosslink records: %v", err)
+		return nil, nil, fmt.Errorf("could not process crosslink records: %v", err)
+	}
+
+	// Track the balance changes of the validators from here on.
+	tracker := bal.NewTracker(state, currentEpoch)
+	if err := tracker.RecordInclusionDistances(state, prevEpochAttesterIndices); err != nil {
+		return nil, nil, fmt.Errorf("could not record inclusion distances: %v", err)
 	}
 
 	// Process attester rewards and penalties.
@@ -282,6 +320,7 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 			prevEpochAttesterIndices,
 			prevEpochAttestingBalance,
 			totalBalance)
+		tracker.Record(state, bal.FFGSourceDelta)
 		log.Infof("Balance after FFG src calculation: %v", state.ValidatorBalances)
 		// Apply rewards/penalties to validators for attesting
 		// expected FFG target.
@@ -291,6 +330,7 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 			prevEpochBoundaryAttesterIndices,
 			prevEpochBoundaryAttestingBalances,
 			totalBalance)
+		tracker.Record(state, bal.FFGTargetDelta)
 		log.Infof("Balance after FFG target calculation: %v", state.ValidatorBalances)
 		// Apply rewards/penalties to validators for attesting
 		// expected beacon chain head.
@@ -300,6 +340,7 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 			prevEpochHeadAttesterIndices,
 			prevEpochHeadAttestingBalances,
 			totalBalance)
+		tracker.Record(state, bal.ChainHeadDelta)
 		log.Infof("Balance after chain head calculation: %v", state.ValidatorBalances)
 		// Apply rewards for to validators for including attestations
 		// based on inclusion distance.
@@ -309,8 +350,9 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 			prevEpochAttesterIndices,
 			totalBalance)
 		if err != nil {
-			return nil, fmt.Errorf("could not calculate inclusion dist rewards: %v", err)
+			return nil, nil, fmt.Errorf("could not calculate inclusion dist rewards: %v", err)
 		}
+		tracker.Record(state, bal.InclusionDistanceDelta)
 		log.Infof("Balance after inclusion distance calculation: %v", state.ValidatorBalances)
 
 	case epochsSinceFinality > 4:
@@ -322,6 +364,7 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 			prevEpochAttesterIndices,
 			totalBalance,
 			epochsSinceFinality)
+		tracker.Record(state, bal.FFGSourceDelta)
 		// Apply penalties for long inactive FFG target participants.
 		state = bal.InactivityFFGTarget(
 			ctx,
@@ -329,6 +372,7 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 			prevEpochBoundaryAttesterIndices,
 			totalBalance,
 			epochsSinceFinality)
+		tracker.Record(state, bal.FFGTargetDelta)
 		// Apply penalties for long inactive validators who didn't
 		// attest to head canonical chain.
 		state = bal.InactivityChainHead(
@@ -336,6 +380,7 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 			state,
 			prevEpochHeadAttesterIndices,
 			totalBalance)
+		tracker.Record(state, bal.ChainHeadDelta)
 		// Apply penalties for long inactive validators who also
 		// exited with penalties.
 		state = bal.InactivityExitedPenalties(
@@ -343,6 +388,7 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 			state,
 			totalBalance,
 			epochsSinceFinality)
+		tracker.Record(state, bal.InactivityPenaltyDelta)
 		// Apply penalties for long inactive validators that
 		// don't include attestations.
 		state, err = bal.InactivityInclusionDistance(
@@ -351,8 +397,9 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 			prevEpochAttesterIndices,
 			totalBalance)
 		if err != nil {
-			return nil, fmt.Errorf("could not calculate inclusion penalties: %v", err)
+			return nil, nil, fmt.Errorf("could not calculate inclusion penalties: %v", err)
 		}
+		tracker.Record(state, bal.InclusionDistanceDelta)
 	}
 
 	// Process Attestation Inclusion Rewards.
@@ -362,8 +409,9 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 		totalBalance,
 		prevEpochAttesterIndices)
 	if err != nil {
-		return nil, fmt.Errorf("could not process attestation inclusion rewards: %v", err)
+		return nil, nil, fmt.Errorf("could not process attestation inclusion rewards: %v", err)
 	}
+	tracker.Record(state, bal.AttestationInclusionDelta)
 
 	// Process crosslink rewards and penalties.
 	state, err = bal.Crosslinks(
@@ -372,31 +420,33 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 		currentEpochAttestations,
 		prevEpochAttestations)
 	if err != nil {
-		return nil, fmt.Errorf("could not process crosslink rewards and penalties: %v", err)
+		return nil, nil, fmt.Errorf("could not process crosslink rewards and penalties: %v", err)
 	}
+	tracker.Record(state, bal.CrosslinkDelta)
 
 	// Process ejections.
 	state, err = e.ProcessEjections(ctx, state)
 	if err != nil {
-		return nil, fmt.Errorf("could not process ejections: %v", err)
+		return nil, nil, fmt.Errorf("could not process ejections: %v", err)
 	}
 
 	// Process validator registry.
 	state = e.ProcessPrevSlotShardSeed(state)
 	state = v.ProcessPenaltiesAndExits(ctx, state)
+	tracker.Record(state, bal.SlashingPenaltyDelta)
 	if e.CanProcessValidatorRegistry(ctx, state) {
 		state, err = v.UpdateRegistry(ctx, state)
 		if err != nil {
-			return nil, fmt.Errorf("could not update validator registry: %v", err)
+			return nil, nil, fmt.Errorf("could not update validator registry: %v", err)
 		}
 		state, err = e.ProcessCurrSlotShardSeed(state)
 		if err != nil {
-			return nil, fmt.Errorf("could not update current shard shuffling seeds: %v", err)
+			return nil, nil, fmt.Errorf("could not update current shard shuffling seeds: %v", err)
 		}
 	} else {
 		state, err = e.ProcessPartialValidatorRegistry(ctx, state)
 		if err != nil {
-			return nil, fmt.Errorf("could not process partial validator registry: %v", err)
+			return nil, nil, fmt.Errorf("could not process partial validator registry: %v", err)
 		}
 	}
 
@@ -404,7 +454,7 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 	// Update index roots from current epoch to next epoch.
 	state, err = e.UpdateLatestActiveIndexRoots(ctx, state)
 	if err != nil {
-		return nil, fmt.Errorf("could not update latest index roots: %v", err)
+		return nil, nil, fmt.Errorf("could not update latest index roots: %v", err)
 	}
 
 	// TODO(1763): Implement process_slashings from ETH2.0 beacon chain spec.
@@ -417,7 +467,7 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 	// Update current epoch's randao seed to next epoch.
 	state, err = e.UpdateLatestRandaoMixes(ctx, state)
 	if err != nil {
-		return nil, fmt.Errorf("could not update latest randao mixes: %v", err)
+		return nil, nil, fmt.Errorf("could not update latest randao mixes: %v", err)
 	}
 
 	// Clean up processed attestations.
@@ -445,5 +495,5 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 
 	// Report interesting metrics.
 	reportEpochTransitionMetrics(state)
-	return state, nil
+	return state, tracker.Performance(state), nil
 }
@@ -448,9 +448,26 @@ func TestProcessEpoch_PassesProcessingConditions(t *testing.T) {
 			params.BeaconConfig().LatestSlashedExitLength),
 	}
 
-	_, err := state.ProcessEpoch(context.Background(), newState)
+	newState, performance, err := state.ProcessEpoch(context.Background(), newState)
 	if err != nil {
-		t.Errorf("Expected epoch transition to pass processing conditions: %v", err)
+		t.Fatalf("Expected epoch transition to pass processing conditions: %v", err)
+	}
+	if len(performance.Validators) != len(validatorRegistry) {
+		t.Fatalf("Expected a performance record for each of the %d validators, received %d",
+			len(validatorRegistry), len(performance.Validators))
+	}
+	for i, record := range performance.Validators {
+		delta := record.FfgSourceDelta + record.FfgTargetDelta + record.ChainHeadDelta +
+			record.InclusionDistanceDelta + record.InactivityPenaltyDelta +
+			record.AttestationInclusionDelta + record.CrosslinkDelta + record.SlashingPenaltyDelta
+		if int64(record.Balance)-int64(params.BeaconConfig().MaxDepositAmount) != delta {
+			t.Errorf("Expected the components of validator %d to add up to its balance change, "+
+				"received %d for a balance of %d", i, delta, record.Balance)
+		}
+		if record.Balance != newState.ValidatorBalances[i] {
+			t.Errorf("Expected balance %d for validator %d, received %d",
+				newState.ValidatorBalances[i], i, record.Balance)
+		}
 	}
 }
 
@@ -508,7 +525,7 @@ func TestProcessEpoch_InactiveConditions(t *testing.T) {
 			params.BeaconConfig().LatestSlashedExitLength),
 	}
 
-	_, err := state.ProcessEpoch(context.Background(), newState)
+	_, _, err := state.ProcessEpoch(context.Background(), newState)
 	if err != nil {
 		t.Errorf("Expected epoch transition to pass processing conditions: %v", err)
 	}
@@ -527,7 +544,7 @@ func TestProcessEpoch_CantGetBoundaryAttestation(t *testing.T) {
 		0,
 		newState.Slot-params.BeaconConfig().GenesisSlot,
 	)
-	if _, err := state.ProcessEpoch(context.Background(), newState); !strings.Contains(err.Error(), want) {
+	if _, _, err := state.ProcessEpoch(context.Background(), newState); !strings.Contains(err.Error(), want) {
 		t.Errorf("Expected: %s, received: %v", want, err)
 	}
 }
@@ -557,7 +574,7 @@ func TestProcessEpoch_CantGetCurrentValidatorIndices(t *testing.T) {
 	}
 
 	wanted := fmt.Sprintf("wanted participants bitfield length %d, got: %d", 0, 1)
-	if _, err := state.ProcessEpoch(context.Background(), newState); !strings.Contains(err.Error(), wanted) {
+	if _, _, err := state.ProcessEpoch(context.Background(), newState); !strings.Contains(err.Error(), wanted) {
 		t.Errorf("Expected: %s, received: %v", wanted, err)
 	}
 }
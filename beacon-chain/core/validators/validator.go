@@ -17,15 +17,6 @@ import (
 	"go.opencensus.io/trace"
 )
 
-var (
-	// ActivatedValidators is a mapping that tracks epoch to activated validators indexes.
-	// Where key is epoch, value is a list of activated validator indexes.
-	ActivatedValidators = make(map[uint64][]uint64)
-	// ExitedValidators is a mapping that tracks epoch to excited validators indexes.
-	// Where key is epoch, value is a list of exited validator indexes.
-	ExitedValidators = make(map[uint64][]uint64)
-)
-
 // ValidatorIndices returns all the validator indices from the input attestations
 // and state.
 //
@@ -298,7 +289,6 @@ func UpdateRegistry(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState
 	defer span.End()
 
 	currentEpoch := helpers.CurrentEpoch(state)
-	updatedEpoch := helpers.EntryExitEffectEpoch(currentEpoch)
 	activeValidatorIndices := helpers.ActiveValidatorIndices(
 		state.ValidatorRegistry, currentEpoch)
 
@@ -321,7 +311,6 @@ func UpdateRegistry(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState
 			if err != nil {
 				return nil, fmt.Errorf("could not activate validator %d: %v", idx, err)
 			}
-			ActivatedValidators[updatedEpoch] = append(ActivatedValidators[updatedEpoch], uint64(idx))
 		}
 	}
 
@@ -335,7 +324,6 @@ func UpdateRegistry(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState
 				break
 			}
 			state = ExitValidator(state, uint64(idx))
-			ExitedValidators[updatedEpoch] = append(ExitedValidators[updatedEpoch], uint64(idx))
 		}
 	}
 	state.ValidatorRegistryUpdateEpoch = currentEpoch
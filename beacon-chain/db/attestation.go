@@ -3,7 +3,6 @@ package db
 import (
 	"fmt"
 
-	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
@@ -17,10 +16,13 @@ func (db *BeaconDB) SaveAttestation(attestation *pb.Attestation) error {
 	}
 	hash := hashutil.Hash(encodedState)
 
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx Tx) error {
 		a := tx.Bucket(attestationBucket)
 
-		return a.Put(hash[:], encodedState)
+		if err := a.Put(hash[:], encodedState); err != nil {
+			return err
+		}
+		return indexAttestation(tx, hash[:], attestation)
 	})
 }
 
@@ -31,17 +33,20 @@ func (db *BeaconDB) DeleteAttestation(attestation *pb.Attestation) error {
 		return err
 	}
 
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx Tx) error {
 		a := tx.Bucket(attestationBucket)
 
-		return a.Delete(hash[:])
+		if err := a.Delete(hash[:]); err != nil {
+			return err
+		}
+		return unindexAttestation(tx, hash[:], attestation)
 	})
 }
 
 // Attestation retrieves an attestation record from the db using its hash.
 func (db *BeaconDB) Attestation(hash [32]byte) (*pb.Attestation, error) {
 	var attestation *pb.Attestation
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx Tx) error {
 		a := tx.Bucket(attestationBucket)
 
 		enc := a.Get(hash[:])
@@ -61,7 +66,7 @@ func (db *BeaconDB) Attestation(hash [32]byte) (*pb.Attestation, error) {
 // These are the attestations that have not been seen on the beacon chain.
 func (db *BeaconDB) Attestations() ([]*pb.Attestation, error) {
 	var attestations []*pb.Attestation
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx Tx) error {
 		a := tx.Bucket(attestationBucket)
 
 		if err := a.ForEach(func(k, v []byte) error {
@@ -80,11 +85,62 @@ func (db *BeaconDB) Attestations() ([]*pb.Attestation, error) {
 	return attestations, err
 }
 
+// AttestationsBySlotRange retrieves the attestation records attesting to a slot between
+// startSlot and endSlot, inclusive, by ascending slot. At most limit attestations are
+// returned, unless limit is zero.
+func (db *BeaconDB) AttestationsBySlotRange(startSlot uint64, endSlot uint64, limit int) ([]*pb.Attestation, error) {
+	var attestations []*pb.Attestation
+	err := db.view(func(tx Tx) error {
+		start, end := uint64Range(startSlot, endSlot)
+		hashes, err := indexedKeys(tx.Bucket(attestationSlotIndexBucket), 8, start, end, limit)
+		if err != nil {
+			return err
+		}
+		attestations, err = indexedAttestations(tx, hashes)
+		return err
+	})
+
+	return attestations, err
+}
+
+// AttestationsByShard retrieves the attestation records of the given shard.
+func (db *BeaconDB) AttestationsByShard(shard uint64) ([]*pb.Attestation, error) {
+	var attestations []*pb.Attestation
+	err := db.view(func(tx Tx) error {
+		start, end := uint64Range(shard, shard)
+		hashes, err := indexedKeys(tx.Bucket(attestationShardIndexBucket), 8, start, end, 0)
+		if err != nil {
+			return err
+		}
+		attestations, err = indexedAttestations(tx, hashes)
+		return err
+	})
+
+	return attestations, err
+}
+
+func indexedAttestations(tx Tx, hashes [][]byte) ([]*pb.Attestation, error) {
+	a := tx.Bucket(attestationBucket)
+	attestations := make([]*pb.Attestation, 0, len(hashes))
+	for _, hash := range hashes {
+		enc := a.Get(hash)
+		if enc == nil {
+			return nil, fmt.Errorf("indexed attestation %#x not found", hash)
+		}
+		attestation, err := createAttestation(enc)
+		if err != nil {
+			return nil, err
+		}
+		attestations = append(attestations, attestation)
+	}
+	return attestations, nil
+}
+
 // HasAttestation checks if the attestation exists.
 func (db *BeaconDB) HasAttestation(hash [32]byte) bool {
 	exists := false
 	// #nosec G104
-	db.view(func(tx *bolt.Tx) error {
+	db.view(func(tx Tx) error {
 		a := tx.Bucket(attestationBucket)
 
 		exists = a.Get(hash[:]) != nil
@@ -2,6 +2,7 @@ package db
 
 import (
 	"bytes"
+	"math"
 	"reflect"
 	"sort"
 	"testing"
@@ -157,3 +158,58 @@ func TestHasAttestation_OK(t *testing.T) {
 		t.Fatal("Expected HasAttestation to return true")
 	}
 }
+
+func TestAttestationsBySlotRangeAndShard_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	var attestations []*pb.Attestation
+	for _, slot := range []uint64{5, 1, 3, 2, 4} {
+		a := &pb.Attestation{
+			Data: &pb.AttestationData{
+				Slot:  slot,
+				Shard: slot % 2,
+			},
+		}
+		if err := db.SaveAttestation(a); err != nil {
+			t.Fatalf("Failed to save attestation: %v", err)
+		}
+		attestations = append(attestations, a)
+	}
+
+	slotsOf := func(atts []*pb.Attestation) []uint64 {
+		var slots []uint64
+		for _, a := range atts {
+			slots = append(slots, a.Data.Slot)
+		}
+		return slots
+	}
+	bySlot, err := db.AttestationsBySlotRange(2, 4, 0)
+	if err != nil {
+		t.Fatalf("Failed to retrieve attestations by slot: %v", err)
+	}
+	if slots := slotsOf(bySlot); !reflect.DeepEqual(slots, []uint64{2, 3, 4}) {
+		t.Errorf("Expected attestations of slots [2 3 4], received %v", slots)
+	}
+	limited, err := db.AttestationsBySlotRange(0, math.MaxUint64, 2)
+	if err != nil {
+		t.Fatalf("Failed to retrieve attestations by slot: %v", err)
+	}
+	if slots := slotsOf(limited); !reflect.DeepEqual(slots, []uint64{1, 2}) {
+		t.Errorf("Expected attestations of slots [1 2], received %v", slots)
+	}
+
+	if err := db.DeleteAttestation(attestations[0]); err != nil {
+		t.Fatalf("Failed to delete attestation: %v", err)
+	}
+	byShard, err := db.AttestationsByShard(1)
+	if err != nil {
+		t.Fatalf("Failed to retrieve attestations by shard: %v", err)
+	}
+	// Attestations of a shard are not ordered by slot.
+	slots := slotsOf(byShard)
+	sort.Slice(slots, func(i, j int) bool { return slots[i] < slots[j] })
+	if !reflect.DeepEqual(slots, []uint64{1, 3}) {
+		t.Errorf("Expected attestations of slots [1 3] in shard 1, received %v", slots)
+	}
+}
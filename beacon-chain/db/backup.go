@@ -0,0 +1,126 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+)
+
+// BackupMetadata describes the chain held by a backup of the DB.
+type BackupMetadata struct {
+	HeadSlot       uint64
+	FinalizedEpoch uint64
+	SchemaVersion  uint64
+	// Size is the number of bytes of the backup.
+	Size uint64
+}
+
+// snapshotTx is implemented by the transactions of stores which can write a copy of their
+// content, which the store can be opened from.
+type snapshotTx interface {
+	WriteTo(w io.Writer) (int64, error)
+}
+
+// Backup writes a consistent copy of the DB to w while the node keeps running. The copy is
+// first written to a temporary file in a single read transaction, which is closed before the
+// copy is streamed to w, so that a slow reader of the backup does not keep the transaction
+// open and the DB file from reclaiming the pages freed meanwhile. The metadata describes the
+// chain as of that transaction.
+func (db *BeaconDB) Backup(w io.Writer) (*BackupMetadata, error) {
+	// The snapshot is kept next to the DB, which is known to have room for a copy of it.
+	snapshotFile, err := ioutil.TempFile(db.DatabasePath, "backup")
+	if err != nil {
+		return nil, fmt.Errorf("could not create backup snapshot file: %v", err)
+	}
+	defer func() {
+		// #nosec G104
+		snapshotFile.Close()
+		// #nosec G104
+		os.Remove(snapshotFile.Name())
+	}()
+
+	metadata := &BackupMetadata{}
+	err = db.view(func(tx Tx) error {
+		snapshot, ok := tx.(snapshotTx)
+		if !ok {
+			return errors.New("the DB store does not support backups")
+		}
+		chainInfo := tx.Bucket(chainInfoBucket)
+		if height := chainInfo.Get(mainChainHeightKey); height != nil {
+			metadata.HeadSlot = decodeToSlotNumber(height)
+		}
+		if enc := chainInfo.Get(stateLookupKey); enc != nil {
+			headState, err := createState(enc)
+			if err != nil {
+				return err
+			}
+			metadata.FinalizedEpoch = headState.FinalizedEpoch
+		}
+		metadata.SchemaVersion = schemaVersion(tx)
+
+		if _, err := snapshot.WriteTo(snapshotFile); err != nil {
+			return fmt.Errorf("could not write backup snapshot: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := snapshotFile.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	size, err := io.Copy(w, snapshotFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not write backup: %v", err)
+	}
+	metadata.Size = uint64(size)
+	return metadata, nil
+}
+
+// RestoreBackup writes the DB backup read from r into the given directory, from which a
+// node can then be started. It refuses to overwrite an existing DB, and checks that the
+// restored DB opens, which migrates it to the latest schema version if needed.
+func RestoreBackup(dirPath string, r io.Reader) error {
+	if err := os.MkdirAll(dirPath, 0700); err != nil {
+		return err
+	}
+	datafile := path.Join(dirPath, "beaconchain.db")
+	if _, err := os.Stat(datafile); err == nil {
+		return fmt.Errorf("a DB already exists in %s", dirPath)
+	}
+
+	// The backup is renamed into place once complete, so that an interrupted restore
+	// never leaves a truncated DB behind.
+	tmpfile := datafile + ".restore"
+	file, err := os.OpenFile(tmpfile, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, r); err != nil {
+		// #nosec G104
+		file.Close()
+		// #nosec G104
+		os.Remove(tmpfile)
+		return fmt.Errorf("could not write DB: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		// #nosec G104
+		os.Remove(tmpfile)
+		return err
+	}
+	if err := os.Rename(tmpfile, datafile); err != nil {
+		return err
+	}
+
+	db, err := NewDB(dirPath)
+	if err != nil {
+		// #nosec G104
+		os.Remove(datafile)
+		return fmt.Errorf("could not open restored DB: %v", err)
+	}
+	return db.Close()
+}
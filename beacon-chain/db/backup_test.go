@@ -0,0 +1,114 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestBackup_RestoresIntoNewDirectory(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	head := setupVerifiedChain(t, db)
+
+	var backup bytes.Buffer
+	metadata, err := db.Backup(&backup)
+	if err != nil {
+		t.Fatalf("Could not back up DB: %v", err)
+	}
+	if metadata.HeadSlot != head.Slot {
+		t.Errorf("Expected head slot %d, received %d", head.Slot, metadata.HeadSlot)
+	}
+	headState, err := db.HeadState(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metadata.FinalizedEpoch != headState.FinalizedEpoch {
+		t.Errorf("Expected finalized epoch %d, received %d", headState.FinalizedEpoch, metadata.FinalizedEpoch)
+	}
+	if metadata.SchemaVersion != latestSchemaVersion() {
+		t.Errorf("Expected schema version %d, received %d", latestSchemaVersion(), metadata.SchemaVersion)
+	}
+	if metadata.Size != uint64(backup.Len()) {
+		t.Errorf("Expected size %d, received %d", backup.Len(), metadata.Size)
+	}
+
+	dir := path.Join(testutil.TempDir(), "restored-backup")
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := RestoreBackup(dir, &backup); err != nil {
+		t.Fatalf("Could not restore backup: %v", err)
+	}
+	restored, err := NewDB(dir)
+	if err != nil {
+		t.Fatalf("Could not open restored DB: %v", err)
+	}
+	defer restored.Close()
+	restoredHead, err := restored.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restoredHead.Slot != params.BeaconConfig().GenesisSlot+1 {
+		t.Errorf("Expected restored head at slot %d, received %d", params.BeaconConfig().GenesisSlot+1, restoredHead.Slot)
+	}
+	corruptions, err := restored.VerifyIntegrity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(corruptions) != 0 {
+		t.Errorf("Expected no corruption in the restored DB, received %v", corruptions)
+	}
+}
+
+func TestRestoreBackup_RefusesExistingDB(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	var backup bytes.Buffer
+	if _, err := db.Backup(&backup); err != nil {
+		t.Fatalf("Could not back up DB: %v", err)
+	}
+	want := "already exists"
+	if err := RestoreBackup(db.DatabasePath, &backup); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
+func TestBackup_UnsupportedStore(t *testing.T) {
+	db, err := NewDBWithStore(NewMemoryStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Backup(&bytes.Buffer{}); err == nil {
+		t.Error("Expected backup of an in-memory DB to fail")
+	}
+}
+
+func TestBackup_RemovesSnapshotFile(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	before, err := ioutil.ReadDir(db.DatabasePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Backup(&bytes.Buffer{}); err != nil {
+		t.Fatalf("Could not back up DB: %v", err)
+	}
+	after, err := ioutil.ReadDir(db.DatabasePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(after) != len(before) {
+		t.Errorf("Expected %d files in the DB directory after the backup, received %d", len(before), len(after))
+	}
+}
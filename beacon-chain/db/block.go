@@ -6,7 +6,6 @@ import (
 
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 
-	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
@@ -24,7 +23,7 @@ func createBlock(enc []byte) (*pb.BeaconBlock, error) {
 // Returns nil if the block does not exist.
 func (db *BeaconDB) Block(root [32]byte) (*pb.BeaconBlock, error) {
 	var block *pb.BeaconBlock
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx Tx) error {
 		bucket := tx.Bucket(blockBucket)
 
 		enc := bucket.Get(root[:])
@@ -44,7 +43,7 @@ func (db *BeaconDB) Block(root [32]byte) (*pb.BeaconBlock, error) {
 func (db *BeaconDB) HasBlock(root [32]byte) bool {
 	hasBlock := false
 	// #nosec G104
-	_ = db.view(func(tx *bolt.Tx) error {
+	_ = db.view(func(tx Tx) error {
 		bucket := tx.Bucket(blockBucket)
 
 		hasBlock = bucket.Get(root[:]) != nil
@@ -66,17 +65,45 @@ func (db *BeaconDB) SaveBlock(block *pb.BeaconBlock) error {
 		return fmt.Errorf("failed to encode block: %v", err)
 	}
 
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx Tx) error {
 		bucket := tx.Bucket(blockBucket)
 
-		return bucket.Put(root[:], enc)
+		if err := bucket.Put(root[:], enc); err != nil {
+			return err
+		}
+		return indexBlock(tx, root[:], block)
 	})
 }
 
+// BlockChildren returns the stored blocks whose parent is the block with the given root.
+func (db *BeaconDB) BlockChildren(root [32]byte) ([]*pb.BeaconBlock, error) {
+	var children []*pb.BeaconBlock
+	err := db.view(func(tx Tx) error {
+		blockBkt := tx.Bucket(blockBucket)
+		roots, err := indexedKeys(tx.Bucket(blockChildrenBucket), len(root), root[:], prefixEnd(root[:]), 0)
+		if err != nil {
+			return err
+		}
+		for _, childRoot := range roots {
+			enc := blockBkt.Get(childRoot)
+			if enc == nil {
+				return fmt.Errorf("indexed child block %#x not found", childRoot)
+			}
+			child, err := createBlock(enc)
+			if err != nil {
+				return err
+			}
+			children = append(children, child)
+		}
+		return nil
+	})
+	return children, err
+}
+
 // ChainHead returns the head of the main chain.
 func (db *BeaconDB) ChainHead() (*pb.BeaconBlock, error) {
 	var block *pb.BeaconBlock
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx Tx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		mainChain := tx.Bucket(mainChainBucket)
 		blockBkt := tx.Bucket(blockBucket)
@@ -105,7 +132,7 @@ func (db *BeaconDB) ChainHead() (*pb.BeaconBlock, error) {
 }
 
 // UpdateChainHead atomically updates the head of the chain as well as the corresponding state changes
-// Including a new state is optional.
+// and the validator index derived from the state. Including a new state is optional.
 func (db *BeaconDB) UpdateChainHead(block *pb.BeaconBlock, beaconState *pb.BeaconState) error {
 	blockRoot, err := hashutil.HashBeaconBlock(block)
 	if err != nil {
@@ -119,7 +146,7 @@ func (db *BeaconDB) UpdateChainHead(block *pb.BeaconBlock, beaconState *pb.Beaco
 
 	slotBinary := encodeSlotNumber(block.Slot)
 
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx Tx) error {
 		blockBucket := tx.Bucket(blockBucket)
 		chainInfo := tx.Bucket(chainInfoBucket)
 		mainChain := tx.Bucket(mainChainBucket)
@@ -139,6 +166,74 @@ func (db *BeaconDB) UpdateChainHead(block *pb.BeaconBlock, beaconState *pb.Beaco
 		if err := chainInfo.Put(stateLookupKey, beaconStateEnc); err != nil {
 			return fmt.Errorf("failed to save beacon state as canonical: %v", err)
 		}
+
+		if err := updateValidatorIndex(tx, beaconState.GetValidatorRegistry()); err != nil {
+			return fmt.Errorf("failed to update validator index: %v", err)
+		}
+		return nil
+	})
+}
+
+// ReorgChainHead atomically switches the main chain to a different branch. Every main chain
+// entry after the common ancestor is removed, the blocks of the new branch are recorded in
+// their place and the last block of the branch becomes the head of the chain along with the
+// given state, from which the validator index is rebuilt. The branch must be sorted by
+// ascending slot and its blocks must already be saved.
+func (db *BeaconDB) ReorgChainHead(ancestor *pb.BeaconBlock, branch []*pb.BeaconBlock, headState *pb.BeaconState) error {
+	if len(branch) == 0 {
+		return errors.New("cannot reorganize the chain to an empty branch")
+	}
+	branchRoots := make([][32]byte, len(branch))
+	for i, block := range branch {
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			return fmt.Errorf("unable to tree hash block: %v", err)
+		}
+		branchRoots[i] = root
+	}
+
+	beaconStateEnc, err := proto.Marshal(headState)
+	if err != nil {
+		return fmt.Errorf("unable to encode beacon state: %v", err)
+	}
+
+	return db.update(func(tx Tx) error {
+		blockBucket := tx.Bucket(blockBucket)
+		chainInfo := tx.Bucket(chainInfoBucket)
+		mainChain := tx.Bucket(mainChainBucket)
+
+		height := chainInfo.Get(mainChainHeightKey)
+		if height == nil {
+			return errors.New("unable to determine chain height")
+		}
+		for slot := ancestor.Slot + 1; slot <= decodeToSlotNumber(height); slot++ {
+			if err := mainChain.Delete(encodeSlotNumber(slot)); err != nil {
+				return fmt.Errorf("failed to remove slot %d from the main chain bucket: %v", slot, err)
+			}
+		}
+
+		for i, block := range branch {
+			if blockBucket.Get(branchRoots[i][:]) == nil {
+				return fmt.Errorf("expected block %#x to have already been saved before reorganizing the chain", branchRoots[i])
+			}
+			if err := mainChain.Put(encodeSlotNumber(block.Slot), branchRoots[i][:]); err != nil {
+				return fmt.Errorf("failed to include the block in the main chain bucket: %v", err)
+			}
+		}
+
+		headSlot := encodeSlotNumber(branch[len(branch)-1].Slot)
+		if err := chainInfo.Put(mainChainHeightKey, headSlot); err != nil {
+			return fmt.Errorf("failed to record the block as the head of the main chain: %v", err)
+		}
+
+		if err := chainInfo.Put(stateLookupKey, beaconStateEnc); err != nil {
+			return fmt.Errorf("failed to save beacon state as canonical: %v", err)
+		}
+
+		// The other branch may have a different registry, so the index is rebuilt.
+		if err := rebuildValidatorIndex(tx, headState.GetValidatorRegistry()); err != nil {
+			return fmt.Errorf("failed to rebuild validator index: %v", err)
+		}
 		return nil
 	})
 }
@@ -149,7 +244,7 @@ func (db *BeaconDB) BlockBySlot(slot uint64) (*pb.BeaconBlock, error) {
 	var block *pb.BeaconBlock
 	slotEnc := encodeSlotNumber(slot)
 
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx Tx) error {
 		mainChain := tx.Bucket(mainChainBucket)
 		blockBkt := tx.Bucket(blockBucket)
 
@@ -177,7 +272,7 @@ func (db *BeaconDB) HasBlockBySlot(slot uint64) (bool, *pb.BeaconBlock, error) {
 	var exists bool
 	slotEnc := encodeSlotNumber(slot)
 
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx Tx) error {
 		mainChain := tx.Bucket(mainChainBucket)
 		blockBkt := tx.Bucket(blockBucket)
 
@@ -1,7 +1,8 @@
 package db
 
 import (
-	"github.com/boltdb/bolt"
+	"fmt"
+
 	"github.com/gogo/protobuf/proto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
@@ -9,26 +10,136 @@ import (
 
 // SaveExit puts the exit request into the beacon chain db.
 func (db *BeaconDB) SaveExit(exit *pb.VoluntaryExit) error {
-	hash, err := hashutil.HashProto(exit)
+	return db.saveOperation(blockOperationsBucket, exit)
+}
+
+// HasExit checks if the exit request exists.
+func (db *BeaconDB) HasExit(hash [32]byte) bool {
+	return db.hasOperation(blockOperationsBucket, hash)
+}
+
+// DeleteExit deletes the exit request from the beacon chain db.
+func (db *BeaconDB) DeleteExit(exit *pb.VoluntaryExit) error {
+	return db.deleteOperation(blockOperationsBucket, exit)
+}
+
+// Exits returns every exit request of the operations pool.
+func (db *BeaconDB) Exits() ([]*pb.VoluntaryExit, error) {
+	var exits []*pb.VoluntaryExit
+	err := db.view(func(tx Tx) error {
+		return tx.Bucket(blockOperationsBucket).ForEach(func(k, v []byte) error {
+			exit, err := createExit(v)
+			if err != nil {
+				return err
+			}
+			exits = append(exits, exit)
+			return nil
+		})
+	})
+	return exits, err
+}
+
+func createExit(enc []byte) (*pb.VoluntaryExit, error) {
+	protoExit := &pb.VoluntaryExit{}
+	if err := proto.Unmarshal(enc, protoExit); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal encoding: %v", err)
+	}
+	return protoExit, nil
+}
+
+// SaveProposerSlashing puts the proposer slashing into the beacon chain db.
+func (db *BeaconDB) SaveProposerSlashing(slashing *pb.ProposerSlashing) error {
+	return db.saveOperation(proposerSlashingsBucket, slashing)
+}
+
+// HasProposerSlashing checks if the proposer slashing exists.
+func (db *BeaconDB) HasProposerSlashing(hash [32]byte) bool {
+	return db.hasOperation(proposerSlashingsBucket, hash)
+}
+
+// DeleteProposerSlashing deletes the proposer slashing from the beacon chain db.
+func (db *BeaconDB) DeleteProposerSlashing(slashing *pb.ProposerSlashing) error {
+	return db.deleteOperation(proposerSlashingsBucket, slashing)
+}
+
+// ProposerSlashings returns every proposer slashing of the operations pool.
+func (db *BeaconDB) ProposerSlashings() ([]*pb.ProposerSlashing, error) {
+	var slashings []*pb.ProposerSlashing
+	err := db.view(func(tx Tx) error {
+		return tx.Bucket(proposerSlashingsBucket).ForEach(func(k, v []byte) error {
+			slashing := &pb.ProposerSlashing{}
+			if err := proto.Unmarshal(v, slashing); err != nil {
+				return fmt.Errorf("failed to unmarshal encoding: %v", err)
+			}
+			slashings = append(slashings, slashing)
+			return nil
+		})
+	})
+	return slashings, err
+}
+
+// SaveAttesterSlashing puts the attester slashing into the beacon chain db.
+func (db *BeaconDB) SaveAttesterSlashing(slashing *pb.AttesterSlashing) error {
+	return db.saveOperation(attesterSlashingsBucket, slashing)
+}
+
+// HasAttesterSlashing checks if the attester slashing exists.
+func (db *BeaconDB) HasAttesterSlashing(hash [32]byte) bool {
+	return db.hasOperation(attesterSlashingsBucket, hash)
+}
+
+// DeleteAttesterSlashing deletes the attester slashing from the beacon chain db.
+func (db *BeaconDB) DeleteAttesterSlashing(slashing *pb.AttesterSlashing) error {
+	return db.deleteOperation(attesterSlashingsBucket, slashing)
+}
+
+// AttesterSlashings returns every attester slashing of the operations pool.
+func (db *BeaconDB) AttesterSlashings() ([]*pb.AttesterSlashing, error) {
+	var slashings []*pb.AttesterSlashing
+	err := db.view(func(tx Tx) error {
+		return tx.Bucket(attesterSlashingsBucket).ForEach(func(k, v []byte) error {
+			slashing := &pb.AttesterSlashing{}
+			if err := proto.Unmarshal(v, slashing); err != nil {
+				return fmt.Errorf("failed to unmarshal encoding: %v", err)
+			}
+			slashings = append(slashings, slashing)
+			return nil
+		})
+	})
+	return slashings, err
+}
+
+// saveOperation puts a block operation into the bucket under its hash.
+func (db *BeaconDB) saveOperation(bucket []byte, operation proto.Message) error {
+	hash, err := hashutil.HashProto(operation)
 	if err != nil {
 		return err
 	}
-	encodedExit, err := proto.Marshal(exit)
+	enc, err := proto.Marshal(operation)
 	if err != nil {
 		return err
 	}
-	return db.update(func(tx *bolt.Tx) error {
-		a := tx.Bucket(blockOperationsBucket)
-		return a.Put(hash[:], encodedExit)
+	return db.update(func(tx Tx) error {
+		return tx.Bucket(bucket).Put(hash[:], enc)
 	})
 }
 
-// HasExit checks if the exit request exists.
-func (db *BeaconDB) HasExit(hash [32]byte) bool {
+// deleteOperation deletes a block operation from the bucket.
+func (db *BeaconDB) deleteOperation(bucket []byte, operation proto.Message) error {
+	hash, err := hashutil.HashProto(operation)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx Tx) error {
+		return tx.Bucket(bucket).Delete(hash[:])
+	})
+}
+
+// hasOperation checks if the bucket holds a block operation with the given hash.
+func (db *BeaconDB) hasOperation(bucket []byte, hash [32]byte) bool {
 	exists := false
-	if err := db.view(func(tx *bolt.Tx) error {
-		b := tx.Bucket(blockOperationsBucket)
-		exists = b.Get(hash[:]) != nil
+	if err := db.view(func(tx Tx) error {
+		exists = tx.Bucket(bucket).Get(hash[:]) != nil
 		return nil
 	}); err != nil {
 		return false
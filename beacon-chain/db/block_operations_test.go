@@ -3,6 +3,7 @@ package db
 import (
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 )
@@ -30,3 +31,125 @@ func TestBeaconDB_HasExit(t *testing.T) {
 		t.Fatal("Expected HasExit to return true")
 	}
 }
+
+func TestBeaconDB_Exits(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	exits, err := db.Exits()
+	if err != nil {
+		t.Fatalf("Failed to retrieve exits: %v", err)
+	}
+	if len(exits) != 0 {
+		t.Fatalf("Expected no exits, received %v", exits)
+	}
+
+	exit := &pb.VoluntaryExit{Epoch: 100, ValidatorIndex: 3}
+	if err := db.SaveExit(exit); err != nil {
+		t.Fatalf("Failed to save exit request: %v", err)
+	}
+	exits, err = db.Exits()
+	if err != nil {
+		t.Fatalf("Failed to retrieve exits: %v", err)
+	}
+	if len(exits) != 1 || !proto.Equal(exits[0], exit) {
+		t.Errorf("Expected exits %v, received %v", []*pb.VoluntaryExit{exit}, exits)
+	}
+
+	if err := db.DeleteExit(exit); err != nil {
+		t.Fatalf("Failed to delete exit request: %v", err)
+	}
+	exits, err = db.Exits()
+	if err != nil {
+		t.Fatalf("Failed to retrieve exits: %v", err)
+	}
+	if len(exits) != 0 {
+		t.Errorf("Expected no exits after deletion, received %v", exits)
+	}
+}
+
+func TestBeaconDB_HasSlashings(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	proposerSlashing := &pb.ProposerSlashing{ProposerIndex: 1}
+	proposerHash, err := hashutil.HashProto(proposerSlashing)
+	if err != nil {
+		t.Fatalf("could not hash proposer slashing: %v", err)
+	}
+	attesterSlashing := &pb.AttesterSlashing{
+		SlashableAttestation_1: &pb.SlashableAttestation{ValidatorIndices: []uint64{1}},
+	}
+	attesterHash, err := hashutil.HashProto(attesterSlashing)
+	if err != nil {
+		t.Fatalf("could not hash attester slashing: %v", err)
+	}
+
+	if db.HasProposerSlashing(proposerHash) || db.HasAttesterSlashing(attesterHash) {
+		t.Fatal("Expected no slashing to be found")
+	}
+	if err := db.SaveProposerSlashing(proposerSlashing); err != nil {
+		t.Fatalf("Failed to save proposer slashing: %v", err)
+	}
+	if !db.HasProposerSlashing(proposerHash) {
+		t.Fatal("Expected HasProposerSlashing to return true")
+	}
+	if db.HasAttesterSlashing(attesterHash) {
+		t.Fatal("Expected the attester slashing to be missing")
+	}
+	if err := db.SaveAttesterSlashing(attesterSlashing); err != nil {
+		t.Fatalf("Failed to save attester slashing: %v", err)
+	}
+	if !db.HasAttesterSlashing(attesterHash) {
+		t.Fatal("Expected HasAttesterSlashing to return true")
+	}
+}
+
+func TestBeaconDB_SlashingsAndDelete(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	proposerSlashing := &pb.ProposerSlashing{ProposerIndex: 1}
+	attesterSlashing := &pb.AttesterSlashing{
+		SlashableAttestation_1: &pb.SlashableAttestation{ValidatorIndices: []uint64{1}},
+	}
+	if err := db.SaveProposerSlashing(proposerSlashing); err != nil {
+		t.Fatalf("Failed to save proposer slashing: %v", err)
+	}
+	if err := db.SaveAttesterSlashing(attesterSlashing); err != nil {
+		t.Fatalf("Failed to save attester slashing: %v", err)
+	}
+
+	proposerSlashings, err := db.ProposerSlashings()
+	if err != nil {
+		t.Fatalf("Failed to get proposer slashings: %v", err)
+	}
+	if len(proposerSlashings) != 1 || !proto.Equal(proposerSlashings[0], proposerSlashing) {
+		t.Errorf("Expected proposer slashings %v, received %v", []*pb.ProposerSlashing{proposerSlashing}, proposerSlashings)
+	}
+	attesterSlashings, err := db.AttesterSlashings()
+	if err != nil {
+		t.Fatalf("Failed to get attester slashings: %v", err)
+	}
+	if len(attesterSlashings) != 1 || !proto.Equal(attesterSlashings[0], attesterSlashing) {
+		t.Errorf("Expected attester slashings %v, received %v", []*pb.AttesterSlashing{attesterSlashing}, attesterSlashings)
+	}
+
+	if err := db.DeleteProposerSlashing(proposerSlashing); err != nil {
+		t.Fatalf("Failed to delete proposer slashing: %v", err)
+	}
+	if err := db.DeleteAttesterSlashing(attesterSlashing); err != nil {
+		t.Fatalf("Failed to delete attester slashing: %v", err)
+	}
+	proposerSlashings, err = db.ProposerSlashings()
+	if err != nil {
+		t.Fatalf("Failed to get proposer slashings: %v", err)
+	}
+	attesterSlashings, err = db.AttesterSlashings()
+	if err != nil {
+		t.Fatalf("Failed to get attester slashings: %v", err)
+	}
+	if len(proposerSlashings) != 0 || len(attesterSlashings) != 0 {
+		t.Errorf("Expected no slashings after deletion, received %v and %v", proposerSlashings, attesterSlashings)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -172,6 +173,101 @@ func TestUpdateChainHead_OK(t *testing.T) {
 	}
 }
 
+func TestReorgChainHead_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	genesis := &pb.BeaconBlock{Slot: 0}
+	genesisRoot, err := hashutil.HashBeaconBlock(genesis)
+	if err != nil {
+		t.Fatalf("failed to hash genesis block: %v", err)
+	}
+	// The current main chain is genesis <- 1 <- 2 <- 3 and the new branch is genesis <- 2' <- 4'.
+	oldBlock1 := &pb.BeaconBlock{Slot: 1, ParentRootHash32: genesisRoot[:]}
+	oldBlock1Root, _ := hashutil.HashBeaconBlock(oldBlock1)
+	oldBlock2 := &pb.BeaconBlock{Slot: 2, ParentRootHash32: oldBlock1Root[:]}
+	oldBlock2Root, _ := hashutil.HashBeaconBlock(oldBlock2)
+	oldBlock3 := &pb.BeaconBlock{Slot: 3, ParentRootHash32: oldBlock2Root[:]}
+	newBlock2 := &pb.BeaconBlock{Slot: 2, ParentRootHash32: genesisRoot[:]}
+	newBlock2Root, _ := hashutil.HashBeaconBlock(newBlock2)
+	newBlock4 := &pb.BeaconBlock{Slot: 4, ParentRootHash32: newBlock2Root[:]}
+
+	for _, block := range []*pb.BeaconBlock{genesis, oldBlock1, oldBlock2, oldBlock3} {
+		if err := db.SaveBlock(block); err != nil {
+			t.Fatalf("failed to save block: %v", err)
+		}
+		if err := db.UpdateChainHead(block, &pb.BeaconState{Slot: block.Slot}); err != nil {
+			t.Fatalf("failed to update head: %v", err)
+		}
+	}
+	for _, block := range []*pb.BeaconBlock{newBlock2, newBlock4} {
+		if err := db.SaveBlock(block); err != nil {
+			t.Fatalf("failed to save block: %v", err)
+		}
+	}
+
+	headState := &pb.BeaconState{Slot: newBlock4.Slot}
+	if err := db.ReorgChainHead(genesis, []*pb.BeaconBlock{newBlock2, newBlock4}, headState); err != nil {
+		t.Fatalf("failed to reorganize the chain: %v", err)
+	}
+
+	head, err := db.ChainHead()
+	if err != nil {
+		t.Fatalf("failed to get chain head: %v", err)
+	}
+	if !proto.Equal(head, newBlock4) {
+		t.Errorf("expected head to be %v, received %v", newBlock4, head)
+	}
+	wanted := map[uint64]*pb.BeaconBlock{
+		0: genesis,
+		1: nil,
+		2: newBlock2,
+		3: nil,
+		4: newBlock4,
+	}
+	for slot, want := range wanted {
+		block, err := db.BlockBySlot(slot)
+		if err != nil {
+			t.Fatalf("failed to get block by slot %d: %v", slot, err)
+		}
+		if !proto.Equal(block, want) {
+			t.Errorf("expected block %v at slot %d, received %v", want, slot, block)
+		}
+	}
+	savedState, err := db.State(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get state: %v", err)
+	}
+	if !proto.Equal(savedState, headState) {
+		t.Errorf("expected head state %v, received %v", headState, savedState)
+	}
+}
+
+func TestReorgChainHead_BranchBlockNotSaved(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	genesis := &pb.BeaconBlock{Slot: 0}
+	if err := db.SaveBlock(genesis); err != nil {
+		t.Fatalf("failed to save block: %v", err)
+	}
+	if err := db.UpdateChainHead(genesis, &pb.BeaconState{}); err != nil {
+		t.Fatalf("failed to update head: %v", err)
+	}
+
+	unsaved := &pb.BeaconBlock{Slot: 1}
+	if err := db.ReorgChainHead(genesis, []*pb.BeaconBlock{unsaved}, &pb.BeaconState{}); err == nil {
+		t.Fatal("expected reorg to fail if a branch block does not exist")
+	}
+	head, err := db.ChainHead()
+	if err != nil {
+		t.Fatalf("failed to get chain head: %v", err)
+	}
+	if !proto.Equal(head, genesis) {
+		t.Errorf("expected failed reorg to leave head at %v, received %v", genesis, head)
+	}
+}
+
 func TestChainProgress_OK(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
@@ -274,3 +370,47 @@ func TestHasBlockBySlot_OK(t *testing.T) {
 	}
 
 }
+
+func TestBlockChildren_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	parent := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 1}
+	parentRoot, err := hashutil.HashBeaconBlock(parent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	child1 := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 2, ParentRootHash32: parentRoot[:]}
+	child2 := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 3, ParentRootHash32: parentRoot[:]}
+	unrelated := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 3, ParentRootHash32: []byte{'A'}}
+	for _, block := range []*pb.BeaconBlock{parent, child1, child2, unrelated} {
+		if err := db.SaveBlock(block); err != nil {
+			t.Fatalf("Failed to save block: %v", err)
+		}
+	}
+
+	children, err := db.BlockChildren(parentRoot)
+	if err != nil {
+		t.Fatalf("Failed to retrieve children: %v", err)
+	}
+	if len(children) != 2 {
+		t.Fatalf("Expected 2 children, received %d", len(children))
+	}
+	for _, child := range children {
+		if !proto.Equal(child, child1) && !proto.Equal(child, child2) {
+			t.Errorf("Unexpected child %v", child)
+		}
+	}
+
+	// Pruned blocks are removed from the children of their parent.
+	if _, err := db.DeleteNonCanonicalBlocks(params.BeaconConfig().GenesisSlot + 3); err != nil {
+		t.Fatalf("Failed to prune blocks: %v", err)
+	}
+	children, err = db.BlockChildren(parentRoot)
+	if err != nil {
+		t.Fatalf("Failed to retrieve children: %v", err)
+	}
+	if len(children) != 1 || !proto.Equal(children[0], child2) {
+		t.Errorf("Expected only child %v to remain, received %v", child2, children)
+	}
+}
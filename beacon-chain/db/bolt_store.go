@@ -0,0 +1,113 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+// boltStore is a Store persisting the buckets in a bolt database file.
+type boltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore opens, or creates, the bolt database of a beacon node in the given directory.
+func NewBoltStore(dirPath string) (Store, error) {
+	if err := os.MkdirAll(dirPath, 0700); err != nil {
+		return nil, err
+	}
+	datafile := path.Join(dirPath, "beaconchain.db")
+	boltDB, err := bolt.Open(datafile, 0600, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+	return &boltStore{db: boltDB}, nil
+}
+
+// NewReadOnlyBoltStore opens the existing bolt database of a beacon node in the given
+// directory without write access. Every write transaction on the store fails.
+func NewReadOnlyBoltStore(dirPath string) (Store, error) {
+	datafile := path.Join(dirPath, "beaconchain.db")
+	if _, err := os.Stat(datafile); err != nil {
+		return nil, err
+	}
+	boltDB, err := bolt.Open(datafile, 0600, &bolt.Options{Timeout: 1 * time.Second, ReadOnly: true})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+	return &boltStore{db: boltDB}, nil
+}
+
+func (s *boltStore) Update(fn func(Tx) error) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	})
+}
+
+func (s *boltStore) Batch(fn func(Tx) error) error {
+	return s.db.Batch(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	})
+}
+
+func (s *boltStore) View(fn func(Tx) error) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	})
+}
+
+func (s *boltStore) Close() error {
+	return s.db.Close()
+}
+
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+func (t *boltTx) Bucket(name []byte) Bucket {
+	// A nil bolt bucket must not be wrapped into a non-nil interface.
+	b := t.tx.Bucket(name)
+	if b == nil {
+		return nil
+	}
+	return &boltBucket{b}
+}
+
+func (t *boltTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	b, err := t.tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, err
+	}
+	return &boltBucket{b}, nil
+}
+
+// WriteTo writes a copy of the whole database file as of the transaction.
+func (t *boltTx) WriteTo(w io.Writer) (int64, error) {
+	return t.tx.WriteTo(w)
+}
+
+// boltBucket adds range iteration to a bolt bucket.
+type boltBucket struct {
+	*bolt.Bucket
+}
+
+func (b *boltBucket) ForEachInRange(start []byte, end []byte, fn func(k []byte, v []byte) error) error {
+	c := b.Cursor()
+	for k, v := c.Seek(start); k != nil && (end == nil || bytes.Compare(k, end) < 0); k, v = c.Next() {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,15 +2,13 @@ package db
 
 import (
 	"errors"
-
-	"github.com/boltdb/bolt"
 )
 
 // CleanedFinalizedSlot returns the most recent finalized slot when we did a DB clean up.
 func (db *BeaconDB) CleanedFinalizedSlot() (uint64, error) {
 	var lastFinalizedSlot uint64
 
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx Tx) error {
 		cleanupHistory := tx.Bucket(cleanupHistoryBucket)
 
 		slotEnc := cleanupHistory.Get(cleanedFinalizedSlotKey)
@@ -30,7 +28,7 @@ func (db *BeaconDB) CleanedFinalizedSlot() (uint64, error) {
 func (db *BeaconDB) SaveCleanedFinalizedSlot(slot uint64) error {
 	slotEnc := encodeSlotNumber(slot)
 
-	err := db.update(func(tx *bolt.Tx) error {
+	err := db.update(func(tx Tx) error {
 		cleanupHistory := tx.Bucket(cleanupHistoryBucket)
 
 		if err := cleanupHistory.Put(cleanedFinalizedSlotKey, slotEnc); err != nil {
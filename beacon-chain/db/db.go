@@ -1,13 +1,8 @@
 package db
 
 import (
-	"errors"
-	"os"
-	"path"
-	"sync"
-	"time"
+	"fmt"
 
-	"github.com/boltdb/bolt"
 	"github.com/sirupsen/logrus"
 )
 
@@ -19,30 +14,32 @@ var log = logrus.WithField("prefix", "beacondb")
 // For example, instead of defining get, put, remove
 // This defines methods such as getBlock, saveBlocksAndAttestations, etc.
 type BeaconDB struct {
-	db           *bolt.DB
+	db           Store
 	DatabasePath string
 
-	// Beacon chain deposits in memory.
-	deposits     []*depositContainer
-	depositsLock sync.RWMutex
+	// Decides which block states are stored in full.
+	statePolicy StateStoragePolicy
+
+	// Number of epochs whose performance records are kept, all of them if zero.
+	performanceRetention uint64
 }
 
-// Close closes the underlying boltdb database.
+// Close closes the underlying store.
 func (db *BeaconDB) Close() error {
 	return db.db.Close()
 }
 
-func (db *BeaconDB) update(fn func(*bolt.Tx) error) error {
+func (db *BeaconDB) update(fn func(Tx) error) error {
 	return db.db.Update(fn)
 }
-func (db *BeaconDB) batch(fn func(*bolt.Tx) error) error {
+func (db *BeaconDB) batch(fn func(Tx) error) error {
 	return db.db.Batch(fn)
 }
-func (db *BeaconDB) view(fn func(*bolt.Tx) error) error {
+func (db *BeaconDB) view(fn func(Tx) error) error {
 	return db.db.View(fn)
 }
 
-func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
+func createBuckets(tx Tx, buckets ...[]byte) error {
 	for _, bucket := range buckets {
 		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
 			return err
@@ -52,29 +49,74 @@ func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
 	return nil
 }
 
-// NewDB initializes a new DB. If the genesis block and states do not exist, this method creates it.
+// NewDB initializes a new DB backed by a bolt database in the given directory.
+// If the genesis block and states do not exist, this method creates it.
 func NewDB(dirPath string) (*BeaconDB, error) {
-	if err := os.MkdirAll(dirPath, 0700); err != nil {
+	store, err := NewBoltStore(dirPath)
+	if err != nil {
 		return nil, err
 	}
-	datafile := path.Join(dirPath, "beaconchain.db")
-	boltDB, err := bolt.Open(datafile, 0600, &bolt.Options{Timeout: 1 * time.Second})
+	db, err := NewDBWithStore(store)
 	if err != nil {
-		if err == bolt.ErrTimeout {
-			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
-		}
 		return nil, err
 	}
+	db.DatabasePath = dirPath
+	return db, nil
+}
 
-	db := &BeaconDB{db: boltDB, DatabasePath: dirPath}
+// NewReadOnlyDB opens the existing bolt database in the given directory for inspection.
+// Unlike NewDB, it neither creates missing buckets nor migrates the schema, so it fails
+// on a DB which was not written by this version of the node.
+func NewReadOnlyDB(dirPath string) (*BeaconDB, error) {
+	store, err := NewReadOnlyBoltStore(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	db := &BeaconDB{
+		db:                   store,
+		DatabasePath:         dirPath,
+		statePolicy:          StoreEpochBoundaryStates,
+		performanceRetention: DefaultPerformanceRetention,
+	}
+	if err := db.view(func(tx Tx) error {
+		for _, bucket := range allBuckets {
+			if tx.Bucket(bucket) == nil {
+				return fmt.Errorf("missing bucket %s", bucket)
+			}
+		}
+		if version := schemaVersion(tx); version != latestSchemaVersion() {
+			return fmt.Errorf("database schema version %d differs from the latest version %d known by this node", version, latestSchemaVersion())
+		}
+		return nil
+	}); err != nil {
+		// #nosec G104
+		store.Close()
+		return nil, err
+	}
+	return db, nil
+}
 
-	if err := db.update(func(tx *bolt.Tx) error {
-		return createBuckets(tx, blockBucket, attestationBucket, mainChainBucket,
-			chainInfoBucket, cleanupHistoryBucket, blockOperationsBucket, validatorBucket)
+// NewDBWithStore initializes a new DB on top of the given key-value store.
+func NewDBWithStore(store Store) (*BeaconDB, error) {
+	db := &BeaconDB{
+		db:                   store,
+		statePolicy:          StoreEpochBoundaryStates,
+		performanceRetention: DefaultPerformanceRetention,
+	}
 
+	if err := db.update(func(tx Tx) error {
+		return createBuckets(tx, allBuckets...)
 	}); err != nil {
 		return nil, err
 	}
 
-	return db, err
+	if err := db.migrateSchema(); err != nil {
+		return nil, err
+	}
+
+	if err := db.countPendingDeposits(); err != nil {
+		return nil, err
+	}
+
+	return db, nil
 }
@@ -0,0 +1,102 @@
+package db
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// indexKey returns the key recording the given record key under an indexed value.
+func indexKey(value []byte, recordKey []byte) []byte {
+	key := make([]byte, 0, len(value)+len(recordKey))
+	return append(append(key, value...), recordKey...)
+}
+
+// uint64IndexValue encodes an indexed number in big-endian, so that the index keys are
+// sorted by ascending number.
+func uint64IndexValue(number uint64) []byte {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, number)
+	return enc
+}
+
+// errIndexLimitReached stops the iteration over an index once enough keys are found.
+var errIndexLimitReached = errors.New("index limit reached")
+
+// indexedKeys returns the record keys recorded under the indexed values in [start, end),
+// ordered by indexed value, where indexed values are valueLen bytes long. A nil end leaves
+// the range unbounded. Iteration stops once limit keys are found, unless limit is zero.
+func indexedKeys(index Bucket, valueLen int, start []byte, end []byte, limit int) ([][]byte, error) {
+	var keys [][]byte
+	err := index.ForEachInRange(start, end, func(k, _ []byte) error {
+		if limit > 0 && len(keys) == limit {
+			return errIndexLimitReached
+		}
+		keys = append(keys, append([]byte{}, k[valueLen:]...))
+		return nil
+	})
+	if err == errIndexLimitReached {
+		err = nil
+	}
+	return keys, err
+}
+
+// uint64Range returns the bounds of the index keys of the numbers in [first, last].
+func uint64Range(first uint64, last uint64) ([]byte, []byte) {
+	if last == math.MaxUint64 {
+		return uint64IndexValue(first), nil
+	}
+	return uint64IndexValue(first), uint64IndexValue(last + 1)
+}
+
+// prefixEnd returns the smallest key greater than every key starting with the prefix, or
+// nil if there is none.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// indexBlock records the block with the given root under its parent root.
+func indexBlock(tx Tx, root []byte, block *pb.BeaconBlock) error {
+	return tx.Bucket(blockChildrenBucket).Put(indexKey(block.ParentRootHash32, root), []byte{})
+}
+
+// unindexBlock removes the block with the given root from the children of its parent.
+func unindexBlock(tx Tx, root []byte, block *pb.BeaconBlock) error {
+	return tx.Bucket(blockChildrenBucket).Delete(indexKey(block.ParentRootHash32, root))
+}
+
+// indexAttestation records the attestation with the given hash under its slot and shard.
+// Attestations without data are not indexed.
+func indexAttestation(tx Tx, hash []byte, attestation *pb.Attestation) error {
+	if attestation.Data == nil {
+		return nil
+	}
+	if err := tx.Bucket(attestationSlotIndexBucket).Put(
+		indexKey(uint64IndexValue(attestation.Data.Slot), hash), []byte{}); err != nil {
+		return err
+	}
+	return tx.Bucket(attestationShardIndexBucket).Put(
+		indexKey(uint64IndexValue(attestation.Data.Shard), hash), []byte{})
+}
+
+// unindexAttestation removes the attestation with the given hash from the indexes.
+func unindexAttestation(tx Tx, hash []byte, attestation *pb.Attestation) error {
+	if attestation.Data == nil {
+		return nil
+	}
+	if err := tx.Bucket(attestationSlotIndexBucket).Delete(
+		indexKey(uint64IndexValue(attestation.Data.Slot), hash)); err != nil {
+		return err
+	}
+	return tx.Bucket(attestationShardIndexBucket).Delete(
+		indexKey(uint64IndexValue(attestation.Data.Shard), hash))
+}
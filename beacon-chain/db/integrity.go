@@ -0,0 +1,222 @@
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// Corruption describes an entry of the DB which cannot be decoded or is inconsistent
+// with the rest of the DB.
+type Corruption struct {
+	Bucket string
+	Key    []byte
+	Reason string
+}
+
+func (c *Corruption) String() string {
+	return fmt.Sprintf("%s %#x: %s", c.Bucket, c.Key, c.Reason)
+}
+
+// VerifyIntegrity checks the consistency of the DB. It walks the main chain to check that
+// every entry refers to a stored block of the same slot whose parent is the previous
+// entry, decodes every stored block, state and attestation, and checks that the validator
+// index matches the registry of the head state. Every problem found is reported, an
+// error is only returned if the DB could not be read.
+func (db *BeaconDB) VerifyIntegrity() ([]*Corruption, error) {
+	var corruptions []*Corruption
+	report := func(bucket []byte, key []byte, format string, args ...interface{}) {
+		corruptions = append(corruptions, &Corruption{
+			Bucket: string(bucket),
+			Key:    append([]byte{}, key...),
+			Reason: fmt.Sprintf(format, args...),
+		})
+	}
+
+	err := db.view(func(tx Tx) error {
+		if err := verifyBlocks(tx, report); err != nil {
+			return err
+		}
+		if err := verifyMainChain(tx, report); err != nil {
+			return err
+		}
+		if err := verifyStates(tx, report); err != nil {
+			return err
+		}
+		return tx.Bucket(attestationBucket).ForEach(func(k, v []byte) error {
+			if _, err := createAttestation(v); err != nil {
+				report(attestationBucket, k, "undecodable attestation: %v", err)
+			}
+			return nil
+		})
+	})
+	return corruptions, err
+}
+
+type reportFunc func(bucket []byte, key []byte, format string, args ...interface{})
+
+// verifyBlocks checks that every stored block decodes and is stored under its root.
+func verifyBlocks(tx Tx, report reportFunc) error {
+	return tx.Bucket(blockBucket).ForEach(func(k, v []byte) error {
+		block, err := createBlock(v)
+		if err != nil {
+			report(blockBucket, k, "undecodable block: %v", err)
+			return nil
+		}
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			report(blockBucket, k, "block cannot be hashed: %v", err)
+			return nil
+		}
+		if !bytes.Equal(root[:], k) {
+			report(blockBucket, k, "block is stored under a different root than its own %#x", root)
+		}
+		return nil
+	})
+}
+
+// verifyMainChain walks the main chain by ascending slot and checks the parent links of
+// its blocks, up to the chain head.
+func verifyMainChain(tx Tx, report reportFunc) error {
+	chainInfo := tx.Bucket(chainInfoBucket)
+	blockBkt := tx.Bucket(blockBucket)
+
+	height := chainInfo.Get(mainChainHeightKey)
+	if height == nil {
+		report(chainInfoBucket, mainChainHeightKey, "chain height is missing")
+		return nil
+	}
+	headSlot := decodeToSlotNumber(height)
+
+	type entry struct {
+		slot uint64
+		key  []byte
+		root []byte
+	}
+	var entries []entry
+	if err := tx.Bucket(mainChainBucket).ForEach(func(k, v []byte) error {
+		if len(k) != 8 {
+			report(mainChainBucket, k, "invalid slot key")
+			return nil
+		}
+		entries = append(entries, entry{
+			slot: decodeToSlotNumber(k),
+			key:  append([]byte{}, k...),
+			root: append([]byte{}, v...),
+		})
+		return nil
+	}); err != nil {
+		return err
+	}
+	// Slot keys are little-endian, so the bucket order is not the slot order.
+	sort.Slice(entries, func(i, j int) bool { return entries[i].slot < entries[j].slot })
+
+	var parentRoot []byte
+	foundHead := false
+	for _, e := range entries {
+		if e.slot > headSlot {
+			report(mainChainBucket, e.key, "entry after the chain head at slot %d", headSlot)
+			continue
+		}
+		foundHead = foundHead || e.slot == headSlot
+		enc := blockBkt.Get(e.root)
+		if enc == nil {
+			report(mainChainBucket, e.key, "block %#x is missing", e.root)
+			parentRoot = nil
+			continue
+		}
+		block, err := createBlock(enc)
+		if err != nil {
+			// Reported by verifyBlocks.
+			parentRoot = nil
+			continue
+		}
+		// The genesis block is recorded under slot 0.
+		if block.Slot != e.slot && !(e.slot == 0 && block.Slot == params.BeaconConfig().GenesisSlot) {
+			report(mainChainBucket, e.key, "block %#x is at slot %d", e.root, block.Slot)
+		}
+		if parentRoot != nil && !bytes.Equal(block.ParentRootHash32, parentRoot) {
+			report(mainChainBucket, e.key, "parent %#x of block %#x is not the previous main chain block %#x",
+				block.ParentRootHash32, e.root, parentRoot)
+		}
+		parentRoot = e.root
+	}
+	if !foundHead {
+		report(chainInfoBucket, mainChainHeightKey, "no main chain entry at the chain height %d", headSlot)
+	}
+	return nil
+}
+
+// verifyStates checks that the stored states decode, and that the validator index matches
+// the registry of the head state.
+func verifyStates(tx Tx, report reportFunc) error {
+	chainInfo := tx.Bucket(chainInfoBucket)
+	blockBkt := tx.Bucket(blockBucket)
+
+	if err := tx.Bucket(stateBucket).ForEach(func(k, v []byte) error {
+		if _, err := createState(v); err != nil {
+			report(stateBucket, k, "undecodable state: %v", err)
+		}
+		if blockBkt.Get(k) == nil {
+			report(stateBucket, k, "state of a block which is not stored")
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if enc := chainInfo.Get(finalizedStateLookupKey); enc == nil {
+		report(chainInfoBucket, finalizedStateLookupKey, "finalized state is missing")
+	} else if _, err := createState(enc); err != nil {
+		report(chainInfoBucket, finalizedStateLookupKey, "undecodable finalized state: %v", err)
+	}
+
+	enc := chainInfo.Get(stateLookupKey)
+	if enc == nil {
+		report(chainInfoBucket, stateLookupKey, "head state is missing")
+		return nil
+	}
+	headState, err := createState(enc)
+	if err != nil {
+		report(chainInfoBucket, stateLookupKey, "undecodable head state: %v", err)
+		return nil
+	}
+	return verifyValidatorIndex(tx, headState.ValidatorRegistry, report)
+}
+
+// verifyValidatorIndex checks that the validator bucket holds exactly the index of every
+// validator of the registry.
+func verifyValidatorIndex(tx Tx, registry []*pb.Validator, report reportFunc) error {
+	bucket := tx.Bucket(validatorBucket)
+	indexed := make(map[[32]byte]bool, len(registry))
+	for i, validator := range registry {
+		h := hashutil.Hash(validator.Pubkey)
+		indexed[h] = true
+		enc := bucket.Get(h[:])
+		if enc == nil {
+			report(validatorBucket, h[:], "validator %d of the head state is not indexed", i)
+			continue
+		}
+		index, n := binary.Uvarint(enc)
+		if n <= 0 {
+			report(validatorBucket, h[:], "undecodable validator index")
+			continue
+		}
+		if index != uint64(i) {
+			report(validatorBucket, h[:], "validator %d of the head state is indexed as %d", i, index)
+		}
+	}
+	return bucket.ForEach(func(k, _ []byte) error {
+		var h [32]byte
+		copy(h[:], k)
+		if len(k) != 32 || !indexed[h] {
+			report(validatorBucket, k, "indexed validator is not in the head state registry")
+		}
+		return nil
+	})
+}
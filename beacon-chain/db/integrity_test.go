@@ -0,0 +1,134 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func setupVerifiedChain(t *testing.T, db *BeaconDB) *pb.BeaconBlock {
+	deposits, _ := setupInitialDeposits(t, 4)
+	if err := db.InitializeState(uint64(time.Now().Unix()), deposits, &pb.Eth1Data{}); err != nil {
+		t.Fatalf("Failed to initialize state: %v", err)
+	}
+	genesis, err := db.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := hashutil.HashBeaconBlock(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	headState, err := db.HeadState(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &pb.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 1,
+		ParentRootHash32: genesisRoot[:],
+	}
+	if err := db.SaveBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(block, headState); err != nil {
+		t.Fatal(err)
+	}
+	return block
+}
+
+func TestVerifyIntegrity_ConsistentDB(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	setupVerifiedChain(t, db)
+
+	corruptions, err := db.VerifyIntegrity()
+	if err != nil {
+		t.Fatalf("Could not verify DB: %v", err)
+	}
+	if len(corruptions) != 0 {
+		t.Errorf("Expected no corruption, received %v", corruptions)
+	}
+}
+
+func TestVerifyIntegrity_ReportsCorruptions(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	setupVerifiedChain(t, db)
+
+	headState, err := db.HeadState(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	orphan := &pb.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 2,
+		ParentRootHash32: []byte{'A'},
+	}
+	if err := db.SaveBlock(orphan); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(orphan, headState); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.update(func(tx Tx) error {
+		if err := tx.Bucket(blockBucket).Put([]byte("garbage"), []byte{0xff, 0xff}); err != nil {
+			return err
+		}
+		h := hashutil.Hash(headState.ValidatorRegistry[0].Pubkey)
+		return tx.Bucket(validatorBucket).Delete(h[:])
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	corruptions, err := db.VerifyIntegrity()
+	if err != nil {
+		t.Fatalf("Could not verify DB: %v", err)
+	}
+	var reasons []string
+	for _, c := range corruptions {
+		reasons = append(reasons, c.String())
+	}
+	report := strings.Join(reasons, "\n")
+	for _, want := range []string{
+		"undecodable block",
+		"is not the previous main chain block",
+		"validator 0 of the head state is not indexed",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("Expected report to contain %q, received:\n%s", want, report)
+		}
+	}
+	if len(corruptions) != 3 {
+		t.Errorf("Expected 3 corruptions, received %d:\n%s", len(corruptions), report)
+	}
+}
+
+func TestNewReadOnlyDB_RejectsWrites(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	setupVerifiedChain(t, db)
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	readOnly, err := NewReadOnlyDB(db.DatabasePath)
+	if err != nil {
+		t.Fatalf("Could not open DB read-only: %v", err)
+	}
+	defer readOnly.Close()
+
+	head, err := readOnly.ChainHead()
+	if err != nil {
+		t.Fatalf("Could not read chain head: %v", err)
+	}
+	if head.Slot != params.BeaconConfig().GenesisSlot+1 {
+		t.Errorf("Expected head at slot %d, received %d", params.BeaconConfig().GenesisSlot+1, head.Slot)
+	}
+	if err := readOnly.SaveBlock(&pb.BeaconBlock{}); err == nil {
+		t.Error("Expected write to a read-only DB to fail")
+	}
+}
@@ -0,0 +1,170 @@
+package db
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+var errTxNotWritable = errors.New("transaction is not writable")
+
+// memoryStore is a Store keeping the buckets in memory. It is meant for tests and
+// simulations, which do not need the data to outlive the process.
+type memoryStore struct {
+	lock    sync.RWMutex
+	buckets map[string]map[string][]byte
+}
+
+// NewMemoryStore creates an empty in-memory store.
+func NewMemoryStore() Store {
+	return &memoryStore{buckets: make(map[string]map[string][]byte)}
+}
+
+func (s *memoryStore) Update(fn func(Tx) error) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	tx := &memoryTx{store: s, writable: true, buckets: make(map[string]*memoryBucket)}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	tx.commit()
+	return nil
+}
+
+func (s *memoryStore) Batch(fn func(Tx) error) error {
+	return s.Update(fn)
+}
+
+func (s *memoryStore) View(fn func(Tx) error) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return fn(&memoryTx{store: s, buckets: make(map[string]*memoryBucket)})
+}
+
+func (s *memoryStore) Close() error {
+	return nil
+}
+
+// memoryTx records the changes of a transaction on top of the store buckets, so that
+// they can be applied at once when the transaction succeeds.
+type memoryTx struct {
+	store    *memoryStore
+	writable bool
+	buckets  map[string]*memoryBucket
+}
+
+func (t *memoryTx) Bucket(name []byte) Bucket {
+	if b, ok := t.buckets[string(name)]; ok {
+		return b
+	}
+	base, ok := t.store.buckets[string(name)]
+	if !ok {
+		return nil
+	}
+	b := newMemoryBucket(t, base)
+	t.buckets[string(name)] = b
+	return b
+}
+
+func (t *memoryTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	if !t.writable {
+		return nil, errTxNotWritable
+	}
+	if b := t.Bucket(name); b != nil {
+		return b, nil
+	}
+	b := newMemoryBucket(t, nil)
+	t.buckets[string(name)] = b
+	return b, nil
+}
+
+func (t *memoryTx) commit() {
+	for name, b := range t.buckets {
+		bucket, ok := t.store.buckets[name]
+		if !ok {
+			bucket = make(map[string][]byte)
+			t.store.buckets[name] = bucket
+		}
+		for k := range b.deleted {
+			delete(bucket, k)
+		}
+		for k, v := range b.written {
+			bucket[k] = v
+		}
+	}
+}
+
+type memoryBucket struct {
+	tx      *memoryTx
+	base    map[string][]byte
+	written map[string][]byte
+	deleted map[string]bool
+}
+
+func newMemoryBucket(tx *memoryTx, base map[string][]byte) *memoryBucket {
+	return &memoryBucket{
+		tx:      tx,
+		base:    base,
+		written: make(map[string][]byte),
+		deleted: make(map[string]bool),
+	}
+}
+
+func (b *memoryBucket) Get(key []byte) []byte {
+	if b.deleted[string(key)] {
+		return nil
+	}
+	if v, ok := b.written[string(key)]; ok {
+		return v
+	}
+	return b.base[string(key)]
+}
+
+func (b *memoryBucket) Put(key []byte, value []byte) error {
+	if !b.tx.writable {
+		return errTxNotWritable
+	}
+	if len(key) == 0 {
+		return errors.New("key required")
+	}
+	b.written[string(key)] = append([]byte{}, value...)
+	delete(b.deleted, string(key))
+	return nil
+}
+
+func (b *memoryBucket) Delete(key []byte) error {
+	if !b.tx.writable {
+		return errTxNotWritable
+	}
+	delete(b.written, string(key))
+	b.deleted[string(key)] = true
+	return nil
+}
+
+func (b *memoryBucket) ForEach(fn func(k []byte, v []byte) error) error {
+	return b.ForEachInRange(nil, nil, fn)
+}
+
+func (b *memoryBucket) ForEachInRange(start []byte, end []byte, fn func(k []byte, v []byte) error) error {
+	inRange := func(k string) bool {
+		return k >= string(start) && (end == nil || k < string(end))
+	}
+	keys := make([]string, 0, len(b.base)+len(b.written))
+	for k := range b.base {
+		if _, ok := b.written[k]; !ok && !b.deleted[k] && inRange(k) {
+			keys = append(keys, k)
+		}
+	}
+	for k := range b.written {
+		if inRange(k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := fn([]byte(k), b.Get([]byte(k))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
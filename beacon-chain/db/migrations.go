@@ -0,0 +1,137 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// migration upgrades the data of the DB from the previous schema version to the next one.
+type migration struct {
+	description string
+	migrate     func(tx Tx) error
+}
+
+// migrations is the ordered list of schema upgrades. The migration at index i upgrades the
+// DB from schema version i to version i+1, so a migration must never be removed or reordered
+// once released. Databases created before schema versioning are at version 0.
+var migrations = []*migration{
+	{
+		description: "index the public key of every validator in the head state registry",
+		migrate:     indexHeadStateValidators,
+	},
+	{
+		description: "index blocks by parent root and attestations by slot and shard",
+		migrate:     indexBlocksAndAttestations,
+	},
+}
+
+// latestSchemaVersion is the schema version written by this version of the node.
+func latestSchemaVersion() uint64 {
+	return uint64(len(migrations))
+}
+
+// SchemaVersion returns the schema version of the data in the DB.
+func (db *BeaconDB) SchemaVersion() (uint64, error) {
+	var version uint64
+	err := db.view(func(tx Tx) error {
+		version = schemaVersion(tx)
+		return nil
+	})
+	return version, err
+}
+
+func schemaVersion(tx Tx) uint64 {
+	enc := tx.Bucket(chainInfoBucket).Get(schemaVersionKey)
+	if enc == nil {
+		return 0
+	}
+	return decodeToSlotNumber(enc)
+}
+
+// migrateSchema brings the DB to the latest schema version. Every migration runs in its own
+// transaction along with the update of the schema version, so an interrupted upgrade resumes
+// from the last completed migration. A new DB is stamped with the latest version directly.
+// It refuses to open a DB with a schema version newer than the one known by this node.
+func (db *BeaconDB) migrateSchema() error {
+	var version uint64
+	var fresh bool
+	if err := db.view(func(tx Tx) error {
+		version = schemaVersion(tx)
+		fresh = tx.Bucket(chainInfoBucket).Get(mainChainHeightKey) == nil
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	latest := latestSchemaVersion()
+	if version > latest {
+		return fmt.Errorf(
+			"database schema version %d is newer than the latest version %d known by this node, please upgrade the node",
+			version,
+			latest,
+		)
+	}
+	if fresh && version == 0 {
+		return db.update(func(tx Tx) error {
+			return tx.Bucket(chainInfoBucket).Put(schemaVersionKey, encodeSlotNumber(latest))
+		})
+	}
+
+	if version == latest {
+		return nil
+	}
+	for ; version < latest; version++ {
+		m := migrations[version]
+		log.WithFields(logrus.Fields{
+			"fromVersion": version,
+			"toVersion":   version + 1,
+		}).Infof("Migrating DB schema: %s", m.description)
+		if err := db.update(func(tx Tx) error {
+			if err := m.migrate(tx); err != nil {
+				return err
+			}
+			return tx.Bucket(chainInfoBucket).Put(schemaVersionKey, encodeSlotNumber(version+1))
+		}); err != nil {
+			return fmt.Errorf("could not migrate DB schema to version %d: %v", version+1, err)
+		}
+	}
+	log.WithField("version", latest).Info("Migrated DB schema")
+	return nil
+}
+
+// indexHeadStateValidators records the index of every validator of the head state, as the
+// validator index used to only be written as validators got activated.
+func indexHeadStateValidators(tx Tx) error {
+	enc := tx.Bucket(chainInfoBucket).Get(stateLookupKey)
+	if enc == nil {
+		return nil
+	}
+	beaconState := &pb.BeaconState{}
+	if err := proto.Unmarshal(enc, beaconState); err != nil {
+		return fmt.Errorf("could not decode head state: %v", err)
+	}
+	return updateValidatorIndex(tx, beaconState.ValidatorRegistry)
+}
+
+// indexBlocksAndAttestations fills the secondary indexes of the stored blocks and attestations.
+func indexBlocksAndAttestations(tx Tx) error {
+	if err := tx.Bucket(blockBucket).ForEach(func(k, v []byte) error {
+		block, err := createBlock(v)
+		if err != nil {
+			return fmt.Errorf("could not decode block %#x: %v", k, err)
+		}
+		return indexBlock(tx, k, block)
+	}); err != nil {
+		return err
+	}
+	return tx.Bucket(attestationBucket).ForEach(func(k, v []byte) error {
+		attestation, err := createAttestation(v)
+		if err != nil {
+			return fmt.Errorf("could not decode attestation %#x: %v", k, err)
+		}
+		return indexAttestation(tx, k, attestation)
+	})
+}
@@ -0,0 +1,111 @@
+package db
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func setVersionedChain(t *testing.T, db *BeaconDB, version uint64) {
+	if err := db.update(func(tx Tx) error {
+		chainInfo := tx.Bucket(chainInfoBucket)
+		if err := chainInfo.Put(mainChainHeightKey, encodeSlotNumber(0)); err != nil {
+			return err
+		}
+		return chainInfo.Put(schemaVersionKey, encodeSlotNumber(version))
+	}); err != nil {
+		t.Fatalf("Could not set schema version: %v", err)
+	}
+}
+
+func TestNewDB_StampsLatestSchemaVersion(t *testing.T) {
+	db, err := NewDBWithStore(NewMemoryStore())
+	if err != nil {
+		t.Fatalf("Could not create DB: %v", err)
+	}
+	version, err := db.SchemaVersion()
+	if err != nil {
+		t.Fatalf("Could not read schema version: %v", err)
+	}
+	if version != latestSchemaVersion() {
+		t.Errorf("Expected schema version %d, received %d", latestSchemaVersion(), version)
+	}
+}
+
+func TestMigrateSchema_RunsPendingMigrationsInOrder(t *testing.T) {
+	defer func(m []*migration) { migrations = m }(migrations)
+	var ran []string
+	record := func(name string) func(Tx) error {
+		return func(tx Tx) error {
+			ran = append(ran, name)
+			return nil
+		}
+	}
+	migrations = []*migration{
+		{description: "first", migrate: record("first")},
+		{description: "second", migrate: record("second")},
+		{description: "third", migrate: record("third")},
+	}
+
+	db, err := NewDBWithStore(NewMemoryStore())
+	if err != nil {
+		t.Fatalf("Could not create DB: %v", err)
+	}
+	if len(ran) != 0 {
+		t.Errorf("Expected no migration to run on a new DB, ran %v", ran)
+	}
+	setVersionedChain(t, db, 1)
+
+	if err := db.migrateSchema(); err != nil {
+		t.Fatalf("Could not migrate schema: %v", err)
+	}
+	if strings.Join(ran, ",") != "second,third" {
+		t.Errorf("Expected migrations second,third to run, ran %v", ran)
+	}
+	version, err := db.SchemaVersion()
+	if err != nil {
+		t.Fatalf("Could not read schema version: %v", err)
+	}
+	if version != 3 {
+		t.Errorf("Expected schema version 3, received %d", version)
+	}
+}
+
+func TestMigrateSchema_FailedMigrationKeepsVersion(t *testing.T) {
+	defer func(m []*migration) { migrations = m }(migrations)
+	migrations = []*migration{
+		{description: "working", migrate: func(tx Tx) error { return nil }},
+		{description: "broken", migrate: func(tx Tx) error { return errors.New("broken") }},
+	}
+
+	db, err := NewDBWithStore(NewMemoryStore())
+	if err != nil {
+		t.Fatalf("Could not create DB: %v", err)
+	}
+	setVersionedChain(t, db, 0)
+
+	if err := db.migrateSchema(); err == nil {
+		t.Fatal("Expected failing migration to return an error")
+	}
+	version, err := db.SchemaVersion()
+	if err != nil {
+		t.Fatalf("Could not read schema version: %v", err)
+	}
+	if version != 1 {
+		t.Errorf("Expected schema version to stop at 1, received %d", version)
+	}
+}
+
+func TestNewDB_RefusesFutureSchemaVersion(t *testing.T) {
+	store := NewMemoryStore()
+	db, err := NewDBWithStore(store)
+	if err != nil {
+		t.Fatalf("Could not create DB: %v", err)
+	}
+	setVersionedChain(t, db, latestSchemaVersion()+1)
+
+	want := "newer than the latest version"
+	if _, err := NewDBWithStore(store); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
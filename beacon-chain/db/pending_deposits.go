@@ -2,8 +2,12 @@ package db
 
 import (
 	"context"
+	"encoding/binary"
+	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -14,7 +18,7 @@ import (
 var (
 	depositsCount = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "beacondb_pending_deposits",
-		Help: "The number of pending deposits in the beaconDB in-memory database",
+		Help: "The number of pending deposits in the beaconDB database",
 	})
 )
 
@@ -25,8 +29,38 @@ type depositContainer struct {
 	block   *big.Int
 }
 
+// depositKey encodes the merkle tree index of a deposit as big-endian, so that
+// pending deposits are iterated in the order of the deposit contract.
+func depositKey(d *pb.Deposit) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, d.MerkleTreeIndex)
+	return key
+}
+
+// encodeDepositContainer stores the block number as a 32 byte big-endian prefix of
+// the encoded deposit.
+func encodeDepositContainer(ctnr *depositContainer) ([]byte, error) {
+	enc, err := proto.Marshal(ctnr.deposit)
+	if err != nil {
+		return nil, err
+	}
+	return append(common.LeftPadBytes(ctnr.block.Bytes(), 32), enc...), nil
+}
+
+func createDepositContainer(enc []byte) (*depositContainer, error) {
+	if len(enc) < 32 {
+		return nil, fmt.Errorf("pending deposit record too short: %d bytes", len(enc))
+	}
+	deposit := &pb.Deposit{}
+	if err := proto.Unmarshal(enc[32:], deposit); err != nil {
+		return nil, err
+	}
+	return &depositContainer{deposit: deposit, block: new(big.Int).SetBytes(enc[:32])}, nil
+}
+
 // InsertPendingDeposit into the database. If deposit or block number are nil
-// then this method does nothing.
+// then this method does nothing. Inserting a deposit with the merkle tree index
+// of a pending deposit replaces it.
 func (db *BeaconDB) InsertPendingDeposit(ctx context.Context, d *pb.Deposit, blockNum *big.Int) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.InsertPendingDeposit")
 	defer span.End()
@@ -37,26 +71,49 @@ func (db *BeaconDB) InsertPendingDeposit(ctx context.Context, d *pb.Deposit, blo
 		}).Debug("Ignoring nil deposit insertion")
 		return
 	}
-	db.depositsLock.Lock()
-	defer db.depositsLock.Unlock()
-	db.deposits = append(db.deposits, &depositContainer{deposit: d, block: blockNum})
-	depositsCount.Inc()
+	enc, err := encodeDepositContainer(&depositContainer{deposit: d, block: blockNum})
+	if err != nil {
+		log.Errorf("Could not encode pending deposit: %v", err)
+		return
+	}
+
+	inserted := false
+	if err := db.update(func(tx Tx) error {
+		deposits := tx.Bucket(pendingDepositsBucket)
+		key := depositKey(d)
+		inserted = deposits.Get(key) == nil
+		return deposits.Put(key, enc)
+	}); err != nil {
+		log.Errorf("Could not save pending deposit: %v", err)
+		return
+	}
+	if inserted {
+		depositsCount.Inc()
+	}
 }
 
 // PendingDeposits returns a list of deposits until the given block number
-// (inclusive). If no block is specified then this method returns all pending
-// deposits.
+// (inclusive), ordered by merkle tree index. If no block is specified then
+// this method returns all pending deposits.
 func (db *BeaconDB) PendingDeposits(ctx context.Context, beforeBlk *big.Int) []*pb.Deposit {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.PendingDeposits")
 	defer span.End()
-	db.depositsLock.RLock()
-	defer db.depositsLock.RUnlock()
 
 	var deposits []*pb.Deposit
-	for _, ctnr := range db.deposits {
-		if beforeBlk == nil || beforeBlk.Cmp(ctnr.block) > -1 {
-			deposits = append(deposits, ctnr.deposit)
-		}
+	if err := db.view(func(tx Tx) error {
+		return tx.Bucket(pendingDepositsBucket).ForEach(func(_, enc []byte) error {
+			ctnr, err := createDepositContainer(enc)
+			if err != nil {
+				return err
+			}
+			if beforeBlk == nil || beforeBlk.Cmp(ctnr.block) > -1 {
+				deposits = append(deposits, ctnr.deposit)
+			}
+			return nil
+		})
+	}); err != nil {
+		log.Errorf("Could not read pending deposits: %v", err)
+		return nil
 	}
 
 	return deposits
@@ -73,19 +130,33 @@ func (db *BeaconDB) RemovePendingDeposit(ctx context.Context, d *pb.Deposit) {
 		return
 	}
 
-	db.depositsLock.Lock()
-	defer db.depositsLock.Unlock()
-
-	idx := -1
-	for i, ctnr := range db.deposits {
-		if ctnr.deposit.MerkleTreeIndex == d.MerkleTreeIndex {
-			idx = i
-			break
-		}
+	removed := false
+	if err := db.update(func(tx Tx) error {
+		deposits := tx.Bucket(pendingDepositsBucket)
+		key := depositKey(d)
+		removed = deposits.Get(key) != nil
+		return deposits.Delete(key)
+	}); err != nil {
+		log.Errorf("Could not remove pending deposit: %v", err)
+		return
 	}
-
-	if idx >= 0 {
-		db.deposits = append(db.deposits[:idx], db.deposits[idx+1:]...)
+	if removed {
 		depositsCount.Dec()
 	}
 }
+
+// countPendingDeposits sets the pending deposits gauge from the deposits stored
+// by a previous run of the node.
+func (db *BeaconDB) countPendingDeposits() error {
+	return db.view(func(tx Tx) error {
+		count := 0
+		if err := tx.Bucket(pendingDepositsBucket).ForEach(func(_, _ []byte) error {
+			count++
+			return nil
+		}); err != nil {
+			return err
+		}
+		depositsCount.Set(float64(count))
+		return nil
+	})
+}
@@ -3,7 +3,6 @@ package db
 import (
 	"context"
 	"math/big"
-	"reflect"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
@@ -11,64 +10,68 @@ import (
 )
 
 func TestInsertPendingDeposit_OK(t *testing.T) {
-	db := BeaconDB{}
+	db := setupDB(t)
+	defer teardownDB(t, db)
 	db.InsertPendingDeposit(context.Background(), &pb.Deposit{}, big.NewInt(111))
 
-	if len(db.deposits) != 1 {
+	if len(db.PendingDeposits(context.Background(), nil)) != 1 {
 		t.Error("Deposit not inserted")
 	}
 }
 
 func TestInsertPendingDeposit_ignoresNilDeposit(t *testing.T) {
-	db := BeaconDB{}
+	db := setupDB(t)
+	defer teardownDB(t, db)
 	db.InsertPendingDeposit(context.Background(), nil /*deposit*/, nil /*blockNum*/)
 
-	if len(db.deposits) > 0 {
+	if len(db.PendingDeposits(context.Background(), nil)) > 0 {
 		t.Error("Unexpected deposit insertion")
 	}
 }
 
 func TestRemovePendingDeposit_OK(t *testing.T) {
-	db := BeaconDB{}
+	db := setupDB(t)
+	defer teardownDB(t, db)
 	depToRemove := &pb.Deposit{MerkleTreeIndex: 1}
 	otherDep := &pb.Deposit{MerkleTreeIndex: 5}
-	db.deposits = []*depositContainer{
-		{deposit: depToRemove},
-		{deposit: otherDep},
-	}
+	db.InsertPendingDeposit(context.Background(), depToRemove, big.NewInt(1))
+	db.InsertPendingDeposit(context.Background(), otherDep, big.NewInt(1))
 	db.RemovePendingDeposit(context.Background(), depToRemove)
 
-	if len(db.deposits) != 1 || !proto.Equal(db.deposits[0].deposit, otherDep) {
+	deposits := db.PendingDeposits(context.Background(), nil)
+	if len(deposits) != 1 || !proto.Equal(deposits[0], otherDep) {
 		t.Error("Failed to remove deposit")
 	}
 }
 
 func TestRemovePendingDeposit_IgnoresNilDeposit(t *testing.T) {
-	db := BeaconDB{}
-	db.deposits = []*depositContainer{{deposit: &pb.Deposit{}}}
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	db.InsertPendingDeposit(context.Background(), &pb.Deposit{}, big.NewInt(1))
 	db.RemovePendingDeposit(context.Background(), nil /*deposit*/)
-	if len(db.deposits) != 1 {
+	if len(db.PendingDeposits(context.Background(), nil)) != 1 {
 		t.Errorf("Deposit unexpectedly removed")
 	}
 }
 
 func TestPendingDeposit_RoundTrip(t *testing.T) {
-	db := BeaconDB{}
+	db := setupDB(t)
+	defer teardownDB(t, db)
 	dep := &pb.Deposit{MerkleTreeIndex: 123}
 	db.InsertPendingDeposit(context.Background(), dep, big.NewInt(111))
 	db.RemovePendingDeposit(context.Background(), dep)
-	if len(db.deposits) != 0 {
+	if len(db.PendingDeposits(context.Background(), nil)) != 0 {
 		t.Error("Failed to insert & delete a pending deposit")
 	}
 }
 
 func TestPendingDeposits_OK(t *testing.T) {
-	db := BeaconDB{}
+	db := setupDB(t)
+	defer teardownDB(t, db)
 
-	db.deposits = []*depositContainer{
-		{block: big.NewInt(2), deposit: &pb.Deposit{MerkleTreeIndex: 2}},
-		{block: big.NewInt(4), deposit: &pb.Deposit{MerkleTreeIndex: 4}},
-		{block: big.NewInt(6), deposit: &pb.Deposit{MerkleTreeIndex: 6}},
+	// Deposits are returned in merkle tree index order, whatever the insertion order.
+	for _, idx := range []uint64{6, 2, 4} {
+		db.InsertPendingDeposit(context.Background(), &pb.Deposit{MerkleTreeIndex: idx}, big.NewInt(int64(idx)))
 	}
 
 	deposits := db.PendingDeposits(context.Background(), big.NewInt(4))
@@ -77,12 +80,41 @@ func TestPendingDeposits_OK(t *testing.T) {
 		{MerkleTreeIndex: 4},
 	}
 
-	if !reflect.DeepEqual(deposits, expected) {
-		t.Errorf("Unexpected deposits. got=%+v want=%+v", deposits, expected)
+	if len(deposits) != len(expected) {
+		t.Fatalf("Unexpected deposits. got=%+v want=%+v", deposits, expected)
+	}
+	for i := range expected {
+		if !proto.Equal(deposits[i], expected[i]) {
+			t.Errorf("Unexpected deposits. got=%+v want=%+v", deposits, expected)
+		}
 	}
 
 	all := db.PendingDeposits(context.Background(), nil)
-	if len(all) != len(db.deposits) {
+	if len(all) != 3 {
 		t.Error("PendingDeposits(ctx, nil) did not return all deposits")
 	}
 }
+
+func TestPendingDeposits_SurviveRestart(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	dep := &pb.Deposit{MerkleTreeIndex: 7, DepositData: []byte{'a'}}
+	db.InsertPendingDeposit(context.Background(), dep, big.NewInt(10))
+	if err := db.Close(); err != nil {
+		t.Fatalf("Could not close DB: %v", err)
+	}
+
+	reopened, err := NewDB(db.DatabasePath)
+	if err != nil {
+		t.Fatalf("Could not reopen DB: %v", err)
+	}
+	*db = *reopened
+
+	if deposits := db.PendingDeposits(context.Background(), big.NewInt(9)); len(deposits) != 0 {
+		t.Errorf("Expected deposit of block 10 to be excluded, received %v", deposits)
+	}
+	deposits := db.PendingDeposits(context.Background(), big.NewInt(10))
+	if len(deposits) != 1 || !proto.Equal(deposits[0], dep) {
+		t.Errorf("Expected deposit %v after restart, received %v", dep, deposits)
+	}
+}
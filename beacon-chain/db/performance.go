@@ -0,0 +1,106 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// DefaultPerformanceRetention is the number of epochs whose performance records are kept by
+// default, about a week of epochs. Each record holds an entry for every validator.
+const DefaultPerformanceRetention = 1575
+
+// SetPerformanceRetention changes the number of epochs whose performance records are kept,
+// counted back from the latest saved epoch. The records are all kept if it is zero.
+func (db *BeaconDB) SetPerformanceRetention(epochs uint64) {
+	db.performanceRetention = epochs
+}
+
+// performanceKey returns the key of the performance record of an epoch transition processed
+// on top of the block with the given root. The records are sorted by epoch, so that the
+// records out of the retention period are pruned with a range deletion.
+func performanceKey(epoch uint64, blockRoot []byte) []byte {
+	return indexKey(uint64IndexValue(epoch), blockRoot)
+}
+
+// SaveEpochPerformance stores the reward and penalty breakdown of the transition at the end
+// of an epoch, processed on the chain of the block with the given root. The block is the
+// latest one of its chain at the end of the epoch. The records of the epochs which fall out
+// of the retention period are deleted.
+func (db *BeaconDB) SaveEpochPerformance(blockRoot [32]byte, performance *pb.EpochPerformance) error {
+	enc, err := proto.Marshal(performance)
+	if err != nil {
+		return fmt.Errorf("failed to encode epoch performance: %v", err)
+	}
+	return db.update(func(tx Tx) error {
+		if err := tx.Bucket(epochPerformanceBucket).Put(performanceKey(performance.Epoch, blockRoot[:]), enc); err != nil {
+			return err
+		}
+		if db.performanceRetention == 0 || performance.Epoch < db.performanceRetention {
+			return nil
+		}
+		return deleteEpochPerformancesBefore(tx, performance.Epoch-db.performanceRetention+1)
+	})
+}
+
+// EpochPerformance returns the reward and penalty breakdown of the transition at the end of
+// the given epoch on the chain of the block with the given root, or nil if it was not stored.
+func (db *BeaconDB) EpochPerformance(blockRoot [32]byte, epoch uint64) (*pb.EpochPerformance, error) {
+	var performance *pb.EpochPerformance
+	err := db.view(func(tx Tx) error {
+		enc := tx.Bucket(epochPerformanceBucket).Get(performanceKey(epoch, blockRoot[:]))
+		if enc == nil {
+			return nil
+		}
+		performance = &pb.EpochPerformance{}
+		if err := proto.Unmarshal(enc, performance); err != nil {
+			return fmt.Errorf("failed to unmarshal encoding: %v", err)
+		}
+		return nil
+	})
+	return performance, err
+}
+
+// deleteEpochPerformances removes the performance records of the epoch transitions processed
+// on top of the blocks with the given roots.
+func deleteEpochPerformances(tx Tx, blockRoots map[string]bool) error {
+	if len(blockRoots) == 0 {
+		return nil
+	}
+	bkt := tx.Bucket(epochPerformanceBucket)
+	var keys [][]byte
+	if err := bkt.ForEach(func(k, _ []byte) error {
+		if blockRoots[string(k[8:])] {
+			keys = append(keys, append([]byte{}, k...))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := bkt.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteEpochPerformancesBefore removes the performance records of the epochs before the
+// given epoch, on every chain.
+func deleteEpochPerformancesBefore(tx Tx, epoch uint64) error {
+	bkt := tx.Bucket(epochPerformanceBucket)
+	var keys [][]byte
+	if err := bkt.ForEachInRange(nil, uint64IndexValue(epoch), func(k, _ []byte) error {
+		keys = append(keys, append([]byte{}, k...))
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := bkt.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestEpochPerformance_SaveAndRetrieve(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	root := [32]byte{'a'}
+	epoch := params.BeaconConfig().GenesisEpoch + 1
+	performance := &pb.EpochPerformance{
+		Epoch: epoch,
+		Validators: []*pb.ValidatorPerformance{
+			{ValidatorIndex: 0, FfgSourceDelta: 10, CrosslinkDelta: -5, InclusionDistance: 1, Balance: 32},
+		},
+	}
+	if err := db.SaveEpochPerformance(root, performance); err != nil {
+		t.Fatalf("Could not save epoch performance: %v", err)
+	}
+
+	received, err := db.EpochPerformance(root, epoch)
+	if err != nil {
+		t.Fatalf("Could not get epoch performance: %v", err)
+	}
+	if !proto.Equal(received, performance) {
+		t.Errorf("Expected %v, received %v", performance, received)
+	}
+	for _, tt := range []struct {
+		root  [32]byte
+		epoch uint64
+	}{
+		{root: root, epoch: epoch + 1},
+		{root: [32]byte{'b'}, epoch: epoch},
+	} {
+		received, err := db.EpochPerformance(tt.root, tt.epoch)
+		if err != nil {
+			t.Fatalf("Could not get epoch performance: %v", err)
+		}
+		if received != nil {
+			t.Errorf("Expected no performance for epoch %d of block %#x, received %v", tt.epoch, tt.root, received)
+		}
+	}
+}
+
+func TestDeleteNonCanonicalBlocks_DeletesEpochPerformances(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	canonical := &pb.BeaconBlock{Slot: genesisSlot + 1}
+	orphaned := &pb.BeaconBlock{Slot: genesisSlot + 1, StateRootHash32: []byte{'a'}}
+	epochs := []uint64{params.BeaconConfig().GenesisEpoch, params.BeaconConfig().GenesisEpoch + 1}
+	roots := make(map[*pb.BeaconBlock][32]byte)
+	for _, block := range []*pb.BeaconBlock{canonical, orphaned} {
+		if err := db.SaveBlock(block); err != nil {
+			t.Fatal(err)
+		}
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		roots[block] = root
+		for _, epoch := range epochs {
+			if err := db.SaveEpochPerformance(root, &pb.EpochPerformance{Epoch: epoch}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := db.UpdateChainHead(canonical, &pb.BeaconState{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.DeleteNonCanonicalBlocks(genesisSlot + 5); err != nil {
+		t.Fatalf("Could not delete non-canonical blocks: %v", err)
+	}
+	for _, epoch := range epochs {
+		kept, err := db.EpochPerformance(roots[canonical], epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if kept == nil {
+			t.Errorf("Expected the performance of epoch %d of the canonical block to be kept", epoch)
+		}
+		deleted, err := db.EpochPerformance(roots[orphaned], epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if deleted != nil {
+			t.Errorf("Expected the performance of epoch %d of the orphaned block to be deleted", epoch)
+		}
+	}
+}
+
+func TestSaveEpochPerformance_DeletesRecordsOutOfRetention(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	db.SetPerformanceRetention(2)
+
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	roots := [][32]byte{{'a'}, {'b'}, {'c'}}
+	for i, root := range roots {
+		if err := db.SaveEpochPerformance(root, &pb.EpochPerformance{Epoch: genesisEpoch + uint64(i)}); err != nil {
+			t.Fatalf("Could not save epoch performance: %v", err)
+		}
+	}
+
+	for i, root := range roots {
+		performance, err := db.EpochPerformance(root, genesisEpoch+uint64(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if kept := i > 0; (performance != nil) != kept {
+			t.Errorf("Expected the performance of epoch %d to be kept: %v, received %v", i, kept, performance)
+		}
+	}
+}
@@ -0,0 +1,298 @@
+package db
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// DeleteNonCanonicalBlocks removes every block before the given slot which is not part of
+// the main chain, along with any state and epoch performance stored for it. Blocks before a
+// finalized slot can no longer become canonical. It returns the number of deleted blocks.
+func (db *BeaconDB) DeleteNonCanonicalBlocks(slot uint64) (int, error) {
+	deleted := 0
+	err := db.update(func(tx Tx) error {
+		blockBkt := tx.Bucket(blockBucket)
+		stateBkt := tx.Bucket(stateBucket)
+		canonical, err := canonicalRoots(tx)
+		if err != nil {
+			return err
+		}
+
+		var stale [][]byte
+		var staleBlocks []*pb.BeaconBlock
+		if err := blockBkt.ForEach(func(root, enc []byte) error {
+			if canonical[string(root)] {
+				return nil
+			}
+			block, err := createBlock(enc)
+			if err != nil {
+				return err
+			}
+			if block.Slot < slot {
+				stale = append(stale, root)
+				staleBlocks = append(staleBlocks, block)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		staleRoots := make(map[string]bool, len(stale))
+		for i, root := range stale {
+			if err := blockBkt.Delete(root); err != nil {
+				return fmt.Errorf("failed to delete block %#x: %v", root, err)
+			}
+			if err := unindexBlock(tx, root, staleBlocks[i]); err != nil {
+				return fmt.Errorf("failed to unindex block %#x: %v", root, err)
+			}
+			if err := stateBkt.Delete(root); err != nil {
+				return fmt.Errorf("failed to delete state of block %#x: %v", root, err)
+			}
+			staleRoots[string(root)] = true
+		}
+		if err := deleteEpochPerformances(tx, staleRoots); err != nil {
+			return fmt.Errorf("failed to delete epoch performances: %v", err)
+		}
+		deleted = len(stale)
+		return nil
+	})
+	return deleted, err
+}
+
+// DeleteSupersededStates removes the stored states of blocks before the given slot, except
+// for the states of the canonical blocks at or right before the start of each epoch, as the
+// start slot of an epoch may have been skipped. The remaining states are enough to regenerate
+// any historical state of the main chain by replaying at most an epoch of blocks. It returns
+// the number of deleted states.
+func (db *BeaconDB) DeleteSupersededStates(slot uint64) (int, error) {
+	deleted := 0
+	err := db.update(func(tx Tx) error {
+		blockBkt := tx.Bucket(blockBucket)
+		stateBkt := tx.Bucket(stateBucket)
+		boundaries, err := epochBoundaryRoots(tx)
+		if err != nil {
+			return err
+		}
+
+		var stale [][]byte
+		if err := stateBkt.ForEach(func(root, _ []byte) error {
+			enc := blockBkt.Get(root)
+			if enc == nil {
+				// The block of the state no longer exists.
+				stale = append(stale, root)
+				return nil
+			}
+			block, err := createBlock(enc)
+			if err != nil {
+				return err
+			}
+			if block.Slot >= slot {
+				return nil
+			}
+			if !boundaries[string(root)] {
+				stale = append(stale, root)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for _, root := range stale {
+			if err := stateBkt.Delete(root); err != nil {
+				return fmt.Errorf("failed to delete state of block %#x: %v", root, err)
+			}
+		}
+		deleted = len(stale)
+		return nil
+	})
+	return deleted, err
+}
+
+// DeleteAttestationsBefore removes every attestation in the attestation pool which attests
+// to a slot before the given slot. It returns the number of deleted attestations.
+func (db *BeaconDB) DeleteAttestationsBefore(slot uint64) (int, error) {
+	deleted := 0
+	err := db.update(func(tx Tx) error {
+		a := tx.Bucket(attestationBucket)
+
+		var stale [][]byte
+		var staleAttestations []*pb.Attestation
+		if err := a.ForEach(func(k, v []byte) error {
+			attestation, err := createAttestation(v)
+			if err != nil {
+				return err
+			}
+			if attestation.Data == nil || attestation.Data.Slot < slot {
+				stale = append(stale, k)
+				staleAttestations = append(staleAttestations, attestation)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for i, k := range stale {
+			if err := a.Delete(k); err != nil {
+				return fmt.Errorf("failed to delete attestation: %v", err)
+			}
+			if err := unindexAttestation(tx, k, staleAttestations[i]); err != nil {
+				return fmt.Errorf("failed to unindex attestation: %v", err)
+			}
+		}
+		deleted = len(stale)
+		return nil
+	})
+	return deleted, err
+}
+
+// DeleteExitsOf removes every voluntary exit in the operations pool which belongs to a
+// validator that has already exited, according to the given state. It returns the number
+// of deleted exits.
+func (db *BeaconDB) DeleteExitsOf(beaconState *pb.BeaconState) (int, error) {
+	deleted := 0
+	err := db.update(func(tx Tx) error {
+		b := tx.Bucket(blockOperationsBucket)
+
+		var stale [][]byte
+		if err := b.ForEach(func(k, v []byte) error {
+			exit, err := createExit(v)
+			if err != nil {
+				return err
+			}
+			if exit.ValidatorIndex >= uint64(len(beaconState.ValidatorRegistry)) {
+				stale = append(stale, k)
+				return nil
+			}
+			validator := beaconState.ValidatorRegistry[exit.ValidatorIndex]
+			if validator.ExitEpoch != params.BeaconConfig().FarFutureEpoch {
+				stale = append(stale, k)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for _, k := range stale {
+			if err := b.Delete(k); err != nil {
+				return fmt.Errorf("failed to delete exit: %v", err)
+			}
+		}
+		deleted = len(stale)
+		return nil
+	})
+	return deleted, err
+}
+
+// DeleteSlashingsOf removes every proposer and attester slashing in the operations pool
+// which can no longer slash a validator, because every validator it covers is already
+// slashed according to the given state. It returns the number of deleted slashings.
+func (db *BeaconDB) DeleteSlashingsOf(beaconState *pb.BeaconState) (int, error) {
+	isSlashed := func(idx uint64) bool {
+		if idx >= uint64(len(beaconState.ValidatorRegistry)) {
+			return true
+		}
+		return beaconState.ValidatorRegistry[idx].SlashedEpoch != params.BeaconConfig().FarFutureEpoch
+	}
+
+	deleted := 0
+	err := db.update(func(tx Tx) error {
+		var staleProposerSlashings [][]byte
+		b := tx.Bucket(proposerSlashingsBucket)
+		if err := b.ForEach(func(k, v []byte) error {
+			slashing := &pb.ProposerSlashing{}
+			if err := proto.Unmarshal(v, slashing); err != nil {
+				return fmt.Errorf("failed to unmarshal encoding: %v", err)
+			}
+			if isSlashed(slashing.ProposerIndex) {
+				staleProposerSlashings = append(staleProposerSlashings, k)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, k := range staleProposerSlashings {
+			if err := b.Delete(k); err != nil {
+				return fmt.Errorf("failed to delete proposer slashing: %v", err)
+			}
+		}
+
+		var staleAttesterSlashings [][]byte
+		b = tx.Bucket(attesterSlashingsBucket)
+		if err := b.ForEach(func(k, v []byte) error {
+			slashing := &pb.AttesterSlashing{}
+			if err := proto.Unmarshal(v, slashing); err != nil {
+				return fmt.Errorf("failed to unmarshal encoding: %v", err)
+			}
+			if slashing.SlashableAttestation_1 == nil || slashing.SlashableAttestation_2 == nil {
+				staleAttesterSlashings = append(staleAttesterSlashings, k)
+				return nil
+			}
+			attesters := make(map[uint64]bool)
+			for _, idx := range slashing.SlashableAttestation_1.ValidatorIndices {
+				attesters[idx] = true
+			}
+			for _, idx := range slashing.SlashableAttestation_2.ValidatorIndices {
+				if attesters[idx] && !isSlashed(idx) {
+					return nil
+				}
+			}
+			staleAttesterSlashings = append(staleAttesterSlashings, k)
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, k := range staleAttesterSlashings {
+			if err := b.Delete(k); err != nil {
+				return fmt.Errorf("failed to delete attester slashing: %v", err)
+			}
+		}
+		deleted = len(staleProposerSlashings) + len(staleAttesterSlashings)
+		return nil
+	})
+	return deleted, err
+}
+
+// epochBoundaryRoots returns the set of main chain block roots which are the latest block at
+// or before the start slot of an epoch.
+func epochBoundaryRoots(tx Tx) (map[string]bool, error) {
+	type entry struct {
+		slot uint64
+		root string
+	}
+	var entries []entry
+	if err := tx.Bucket(mainChainBucket).ForEach(func(k, root []byte) error {
+		entries = append(entries, entry{slot: decodeToSlotNumber(k), root: string(root)})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].slot < entries[j].slot
+	})
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	roots := make(map[string]bool)
+	for i, e := range entries {
+		// A block is the latest one at or before an epoch start if it is at the epoch start,
+		// or if the next canonical block comes after the start of the following epoch.
+		nextEpochStart := (e.slot/slotsPerEpoch + 1) * slotsPerEpoch
+		if e.slot%slotsPerEpoch == 0 || i == len(entries)-1 || entries[i+1].slot > nextEpochStart {
+			roots[e.root] = true
+		}
+	}
+	return roots, nil
+}
+
+// canonicalRoots returns the set of block roots recorded in the main chain bucket.
+func canonicalRoots(tx Tx) (map[string]bool, error) {
+	roots := make(map[string]bool)
+	err := tx.Bucket(mainChainBucket).ForEach(func(_, root []byte) error {
+		roots[string(root)] = true
+		return nil
+	})
+	return roots, err
+}
@@ -0,0 +1,180 @@
+package db
+
+import (
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestDeleteSupersededStates_KeepsCanonicalEpochBoundaries(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	db.SetStateStoragePolicy(StoreAllStates)
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	boundary := &pb.BeaconBlock{Slot: genesisSlot}
+	midEpoch := &pb.BeaconBlock{Slot: genesisSlot + 1}
+	// The start slot of the next epoch is skipped, so this is its latest ancestor.
+	beforeSkippedBoundary := &pb.BeaconBlock{Slot: genesisSlot + 2}
+	orphaned := &pb.BeaconBlock{Slot: genesisSlot, StateRootHash32: []byte{'a'}}
+	recent := &pb.BeaconBlock{Slot: genesisSlot + params.BeaconConfig().SlotsPerEpoch + 1}
+	roots := make(map[*pb.BeaconBlock][32]byte)
+	for _, block := range []*pb.BeaconBlock{boundary, midEpoch, beforeSkippedBoundary, orphaned, recent} {
+		if err := db.SaveBlock(block); err != nil {
+			t.Fatal(err)
+		}
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		roots[block] = root
+		if _, err := db.SaveStateForBlock(root, &pb.BeaconState{Slot: block.Slot}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, block := range []*pb.BeaconBlock{boundary, midEpoch, beforeSkippedBoundary, recent} {
+		if err := db.UpdateChainHead(block, &pb.BeaconState{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deleted, err := db.DeleteSupersededStates(genesisSlot + params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		t.Fatalf("Could not delete superseded states: %v", err)
+	}
+	if deleted != 2 {
+		t.Errorf("Expected 2 deleted states, received %d", deleted)
+	}
+	tests := []struct {
+		block *pb.BeaconBlock
+		kept  bool
+	}{
+		{block: boundary, kept: true},
+		{block: midEpoch, kept: false},
+		{block: beforeSkippedBoundary, kept: true},
+		{block: orphaned, kept: false},
+		{block: recent, kept: true},
+	}
+	for _, tt := range tests {
+		if db.HasStateForBlock(roots[tt.block]) != tt.kept {
+			t.Errorf("Expected state of block at slot %d to be kept: %v", tt.block.Slot-genesisSlot, tt.kept)
+		}
+	}
+}
+
+func TestDeleteNonCanonicalBlocks_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	canonical := &pb.BeaconBlock{Slot: genesisSlot + 1}
+	orphaned := &pb.BeaconBlock{Slot: genesisSlot + 1, StateRootHash32: []byte{'a'}}
+	recentOrphan := &pb.BeaconBlock{Slot: genesisSlot + 10, StateRootHash32: []byte{'a'}}
+	for _, block := range []*pb.BeaconBlock{canonical, orphaned, recentOrphan} {
+		if err := db.SaveBlock(block); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.UpdateChainHead(canonical, &pb.BeaconState{}); err != nil {
+		t.Fatal(err)
+	}
+
+	deleted, err := db.DeleteNonCanonicalBlocks(genesisSlot + 5)
+	if err != nil {
+		t.Fatalf("Could not delete non-canonical blocks: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("Expected 1 deleted block, received %d", deleted)
+	}
+	for _, tt := range []struct {
+		block *pb.BeaconBlock
+		kept  bool
+	}{
+		{block: canonical, kept: true},
+		{block: orphaned, kept: false},
+		{block: recentOrphan, kept: true},
+	} {
+		root, err := hashutil.HashBeaconBlock(tt.block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if db.HasBlock(root) != tt.kept {
+			t.Errorf("Expected block at slot %d to be kept: %v", tt.block.Slot-genesisSlot, tt.kept)
+		}
+	}
+}
+
+func TestDeleteSlashingsOf_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	beaconState := &pb.BeaconState{
+		ValidatorRegistry: []*pb.Validator{
+			{SlashedEpoch: farFuture},
+			{SlashedEpoch: params.BeaconConfig().GenesisEpoch},
+			{SlashedEpoch: farFuture},
+		},
+	}
+	attesterSlashing := func(indices1 []uint64, indices2 []uint64) *pb.AttesterSlashing {
+		return &pb.AttesterSlashing{
+			SlashableAttestation_1: &pb.SlashableAttestation{ValidatorIndices: indices1},
+			SlashableAttestation_2: &pb.SlashableAttestation{ValidatorIndices: indices2},
+		}
+	}
+	proposerSlashings := []struct {
+		slashing *pb.ProposerSlashing
+		kept     bool
+	}{
+		{slashing: &pb.ProposerSlashing{ProposerIndex: 0}, kept: true},
+		{slashing: &pb.ProposerSlashing{ProposerIndex: 1}, kept: false},
+		{slashing: &pb.ProposerSlashing{ProposerIndex: 3}, kept: false},
+	}
+	attesterSlashings := []struct {
+		slashing *pb.AttesterSlashing
+		kept     bool
+	}{
+		// Validator 2 can still be slashed.
+		{slashing: attesterSlashing([]uint64{1, 2}, []uint64{1, 2}), kept: true},
+		// Validator 1 is the only validator in both attestations, and it is already slashed.
+		{slashing: attesterSlashing([]uint64{0, 1}, []uint64{1, 2}), kept: false},
+	}
+	for _, tt := range proposerSlashings {
+		if err := db.SaveProposerSlashing(tt.slashing); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, tt := range attesterSlashings {
+		if err := db.SaveAttesterSlashing(tt.slashing); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deleted, err := db.DeleteSlashingsOf(beaconState)
+	if err != nil {
+		t.Fatalf("Could not delete slashings: %v", err)
+	}
+	if deleted != 3 {
+		t.Errorf("Expected 3 deleted slashings, received %d", deleted)
+	}
+	for _, tt := range proposerSlashings {
+		h, err := hashutil.HashProto(tt.slashing)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if db.HasProposerSlashing(h) != tt.kept {
+			t.Errorf("Expected slashing of proposer %d to be kept: %v", tt.slashing.ProposerIndex, tt.kept)
+		}
+	}
+	for _, tt := range attesterSlashings {
+		h, err := hashutil.HashProto(tt.slashing)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if db.HasAttesterSlashing(h) != tt.kept {
+			t.Errorf("Expected attester slashing %v to be kept: %v", tt.slashing, tt.kept)
+		}
+	}
+}
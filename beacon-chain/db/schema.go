@@ -12,6 +12,32 @@ import (
 // We store the state using the state lookup key, and
 // also the genesis block using the genesis lookup key.
 // The canonical head is stored using the canonical head lookup key.
+//
+// Block post-states which are stored in full are kept in the state bucket.
+// `state-bucket` + block root -> state
+//
+// Deposits which are not included in a block yet are kept in the pending deposits bucket,
+// along with the number of the ETH1 block which included them.
+// `pending-deposits-bucket` + big-endian merkle tree index -> block number + deposit
+//
+// Slashings which are not included in a block yet are kept in the operations pool.
+// `proposer-slashings-bucket` + slashing hash -> proposer slashing
+// `attester-slashings-bucket` + slashing hash -> attester slashing
+//
+// The reward and penalty breakdown of an epoch transition is kept under the root of the
+// latest block of the chain at the end of the epoch.
+// `epoch-performance-bucket` + block root + big-endian epoch -> epoch performance
+//
+// Secondary indexes map a property of the records of a bucket to the keys of the records
+// having it. An index key is the indexed value followed by the record key, with an empty
+// value, so that the records with a given value are found by iterating over its prefix.
+// Indexes are updated in the same transaction as the records they index.
+// `block-children-bucket` + parent root + block root -> nil
+// `attestation-slot-index-bucket` + big-endian slot + attestation hash -> nil
+// `attestation-shard-index-bucket` + big-endian shard + attestation hash -> nil
+//
+// The version of this schema is kept under the schema version key of the chain info
+// bucket. Changing how data is stored requires a new migration in migrations.go.
 
 // The fields below define the suffix of keys in the db.
 var (
@@ -21,16 +47,47 @@ var (
 	mainChainBucket       = []byte("main-chain-bucket")
 	chainInfoBucket       = []byte("chain-info")
 	validatorBucket       = []byte("validator")
+	stateBucket           = []byte("state-bucket")
+	pendingDepositsBucket = []byte("pending-deposits-bucket")
+
+	proposerSlashingsBucket = []byte("proposer-slashings-bucket")
+	attesterSlashingsBucket = []byte("attester-slashings-bucket")
+
+	epochPerformanceBucket = []byte("epoch-performance-bucket")
+
+	blockChildrenBucket         = []byte("block-children-bucket")
+	attestationSlotIndexBucket  = []byte("attestation-slot-index-bucket")
+	attestationShardIndexBucket = []byte("attestation-shard-index-bucket")
 
 	mainChainHeightKey      = []byte("chain-height")
 	stateLookupKey          = []byte("state")
 	finalizedStateLookupKey = []byte("finalized-state")
+	schemaVersionKey        = []byte("schema-version")
 
 	// DB internal use
 	cleanupHistoryBucket    = []byte("cleanup-history-bucket")
 	cleanedFinalizedSlotKey = []byte("cleaned-finalized-slot")
 )
 
+// allBuckets lists every bucket of the DB, which are created when the DB is opened.
+var allBuckets = [][]byte{
+	blockBucket,
+	attestationBucket,
+	mainChainBucket,
+	chainInfoBucket,
+	cleanupHistoryBucket,
+	blockOperationsBucket,
+	validatorBucket,
+	stateBucket,
+	pendingDepositsBucket,
+	proposerSlashingsBucket,
+	attesterSlashingsBucket,
+	epochPerformanceBucket,
+	blockChildrenBucket,
+	attestationSlotIndexBucket,
+	attestationShardIndexBucket,
+}
+
 // encodeSlotNumber encodes a slot number as little-endian uint32.
 func encodeSlotNumber(number uint64) []byte {
 	return bytesutil.Bytes8(number)
@@ -2,20 +2,41 @@ package db
 
 import (
 	"context"
-	"encoding/binary"
 	"errors"
 	"fmt"
 	"time"
 
-	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 )
 
+// StateStoragePolicy decides whether the post-state of a block is stored in full by
+// SaveStateForBlock. States which are not stored have to be regenerated by replaying
+// blocks on top of the closest stored ancestor state.
+type StateStoragePolicy func(beaconState *pb.BeaconState) bool
+
+// StoreAllStates stores the post-state of every block. This makes historical queries
+// cheap at the expense of disk usage.
+func StoreAllStates(beaconState *pb.BeaconState) bool {
+	return true
+}
+
+// StoreEpochBoundaryStates only stores the post-states of blocks at the first slot of an
+// epoch, so that regenerating any other state replays at most an epoch of blocks.
+func StoreEpochBoundaryStates(beaconState *pb.BeaconState) bool {
+	return beaconState.Slot%params.BeaconConfig().SlotsPerEpoch == 0
+}
+
+// SetStateStoragePolicy changes which block states are stored in full by SaveStateForBlock.
+func (db *BeaconDB) SetStateStoragePolicy(policy StateStoragePolicy) {
+	db.statePolicy = policy
+}
+
 // InitializeState creates an initial genesis state for the beacon
 // node using a set of genesis validators.
 func (db *BeaconDB) InitializeState(genesisTime uint64, deposits []*pb.Deposit, eth1Data *pb.Eth1Data) error {
@@ -32,37 +53,76 @@ func (db *BeaconDB) InitializeState(genesisTime uint64, deposits []*pb.Deposit,
 	blockRoot, _ := hashutil.HashBeaconBlock(genesisBlock)
 	// #nosec G104
 	blockEnc, _ := proto.Marshal(genesisBlock)
-	zeroBinary := encodeSlotNumber(0)
 
-	return db.update(func(tx *bolt.Tx) error {
-		blockBkt := tx.Bucket(blockBucket)
-		validatorBkt := tx.Bucket(validatorBucket)
-		mainChain := tx.Bucket(mainChainBucket)
-		chainInfo := tx.Bucket(chainInfoBucket)
+	return db.update(func(tx Tx) error {
+		return initializeChain(tx, encodeSlotNumber(0), blockRoot, genesisBlock, blockEnc, beaconState, stateEnc)
+	})
+}
 
-		if err := chainInfo.Put(mainChainHeightKey, zeroBinary); err != nil {
-			return fmt.Errorf("failed to record block height: %v", err)
-		}
+// InitializeStateFromAnchor starts the chain of an empty DB from a trusted block and its
+// post-state instead of the genesis state, such as the anchor of a chain archive. The
+// anchor becomes the chain head and the finalized state which other states are
+// regenerated from, so its ancestors do not need to be known.
+func (db *BeaconDB) InitializeStateFromAnchor(block *pb.BeaconBlock, beaconState *pb.BeaconState) error {
+	blockRoot, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("unable to tree hash block: %v", err)
+	}
+	blockEnc, err := proto.Marshal(block)
+	if err != nil {
+		return fmt.Errorf("unable to encode block: %v", err)
+	}
+	stateEnc, err := proto.Marshal(beaconState)
+	if err != nil {
+		return fmt.Errorf("unable to encode beacon state: %v", err)
+	}
 
-		if err := mainChain.Put(zeroBinary, blockRoot[:]); err != nil {
-			return fmt.Errorf("failed to record block hash: %v", err)
+	return db.update(func(tx Tx) error {
+		if tx.Bucket(chainInfoBucket).Get(mainChainHeightKey) != nil {
+			return errors.New("cannot initialize the chain of a DB which already holds one")
 		}
+		return initializeChain(tx, encodeSlotNumber(block.Slot), blockRoot, block, blockEnc, beaconState, stateEnc)
+	})
+}
 
-		if err := blockBkt.Put(blockRoot[:], blockEnc); err != nil {
-			return err
-		}
+// initializeChain records the first block of the main chain along with its state, which
+// is stored in full as every other state descends from it.
+func initializeChain(tx Tx, slotBinary []byte, blockRoot [32]byte, block *pb.BeaconBlock, blockEnc []byte,
+	beaconState *pb.BeaconState, stateEnc []byte) error {
+	blockBkt := tx.Bucket(blockBucket)
+	mainChain := tx.Bucket(mainChainBucket)
+	chainInfo := tx.Bucket(chainInfoBucket)
+	stateBkt := tx.Bucket(stateBucket)
 
-		for i, validator := range beaconState.ValidatorRegistry {
-			h := hashutil.Hash(validator.Pubkey)
-			buf := make([]byte, binary.MaxVarintLen64)
-			n := binary.PutUvarint(buf, uint64(i))
-			if err := validatorBkt.Put(h[:], buf[:n]); err != nil {
-				return err
-			}
-		}
+	if err := chainInfo.Put(mainChainHeightKey, slotBinary); err != nil {
+		return fmt.Errorf("failed to record block height: %v", err)
+	}
 
-		return chainInfo.Put(stateLookupKey, stateEnc)
-	})
+	if err := mainChain.Put(slotBinary, blockRoot[:]); err != nil {
+		return fmt.Errorf("failed to record block hash: %v", err)
+	}
+
+	if err := blockBkt.Put(blockRoot[:], blockEnc); err != nil {
+		return err
+	}
+	if err := indexBlock(tx, blockRoot[:], block); err != nil {
+		return err
+	}
+
+	if err := updateValidatorIndex(tx, beaconState.ValidatorRegistry); err != nil {
+		return err
+	}
+
+	if err := stateBkt.Put(blockRoot[:], stateEnc); err != nil {
+		return fmt.Errorf("failed to record initial state: %v", err)
+	}
+
+	// The initial state is the first finalized state of the chain.
+	if err := chainInfo.Put(finalizedStateLookupKey, stateEnc); err != nil {
+		return fmt.Errorf("failed to record finalized state: %v", err)
+	}
+
+	return chainInfo.Put(stateLookupKey, stateEnc)
 }
 
 // State fetches the canonical beacon chain's state from the DB.
@@ -70,7 +130,7 @@ func (db *BeaconDB) State(ctx context.Context) (*pb.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.State")
 	defer span.End()
 	var beaconState *pb.BeaconState
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx Tx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		enc := chainInfo.Get(stateLookupKey)
 		if enc == nil {
@@ -87,7 +147,7 @@ func (db *BeaconDB) State(ctx context.Context) (*pb.BeaconState, error) {
 
 // SaveState updates the beacon chain state.
 func (db *BeaconDB) SaveState(beaconState *pb.BeaconState) error {
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx Tx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		beaconStateEnc, err := proto.Marshal(beaconState)
 		if err != nil {
@@ -97,9 +157,103 @@ func (db *BeaconDB) SaveState(beaconState *pb.BeaconState) error {
 	})
 }
 
+// HeadState fetches the state of the current chain head. The post-state of the head block
+// is returned if it was stored in full, otherwise the canonical state saved along with the
+// chain head is returned.
+func (db *BeaconDB) HeadState(ctx context.Context) (*pb.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.HeadState")
+	defer span.End()
+	var beaconState *pb.BeaconState
+	err := db.view(func(tx Tx) error {
+		chainInfo := tx.Bucket(chainInfoBucket)
+		mainChain := tx.Bucket(mainChainBucket)
+		stateBkt := tx.Bucket(stateBucket)
+
+		height := chainInfo.Get(mainChainHeightKey)
+		if height == nil {
+			return errors.New("unable to determine chain height")
+		}
+		headRoot := mainChain.Get(height)
+		if headRoot == nil {
+			return fmt.Errorf("root at the current height not found: %d", height)
+		}
+
+		enc := stateBkt.Get(headRoot)
+		if enc == nil {
+			enc = chainInfo.Get(stateLookupKey)
+		}
+		if enc == nil {
+			return nil
+		}
+
+		var err error
+		beaconState, err = createState(enc)
+		return err
+	})
+
+	return beaconState, err
+}
+
+// StateByRoot fetches the post-state of the block with the given root.
+// Returns nil if the state of the block was not stored in full.
+func (db *BeaconDB) StateByRoot(blockRoot [32]byte) (*pb.BeaconState, error) {
+	var beaconState *pb.BeaconState
+	err := db.view(func(tx Tx) error {
+		enc := tx.Bucket(stateBucket).Get(blockRoot[:])
+		if enc == nil {
+			return nil
+		}
+
+		var err error
+		beaconState, err = createState(enc)
+		return err
+	})
+
+	return beaconState, err
+}
+
+// HasStateForBlock returns true if the post-state of the block with the given
+// root is stored in full.
+func (db *BeaconDB) HasStateForBlock(blockRoot [32]byte) bool {
+	hasState := false
+	// #nosec G104
+	_ = db.view(func(tx Tx) error {
+		hasState = tx.Bucket(stateBucket).Get(blockRoot[:]) != nil
+		return nil
+	})
+
+	return hasState
+}
+
+// SaveStateForBlock stores the post-state of the block with the given root, if the
+// state storage policy of the DB selects it to be stored in full. It returns true if
+// the state was stored.
+func (db *BeaconDB) SaveStateForBlock(blockRoot [32]byte, beaconState *pb.BeaconState) (bool, error) {
+	if !db.statePolicy(beaconState) {
+		return false, nil
+	}
+	enc, err := proto.Marshal(beaconState)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode state: %v", err)
+	}
+	if err := db.update(func(tx Tx) error {
+		return tx.Bucket(stateBucket).Put(blockRoot[:], enc)
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// DeleteStateForBlock removes the stored post-state of the block with the given root.
+func (db *BeaconDB) DeleteStateForBlock(blockRoot [32]byte) error {
+	return db.update(func(tx Tx) error {
+		return tx.Bucket(stateBucket).Delete(blockRoot[:])
+	})
+}
+
 // SaveFinalizedState saves the last finazlied state in the db.
 func (db *BeaconDB) SaveFinalizedState(beaconState *pb.BeaconState) error {
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx Tx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		beaconStateEnc, err := proto.Marshal(beaconState)
 		if err != nil {
@@ -112,7 +266,7 @@ func (db *BeaconDB) SaveFinalizedState(beaconState *pb.BeaconState) error {
 // FinalizedState retrieves the finalized state from the db.
 func (db *BeaconDB) FinalizedState() (*pb.BeaconState, error) {
 	var beaconState *pb.BeaconState
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx Tx) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		encState := chainInfo.Get(finalizedStateLookupKey)
 		if encState == nil {
@@ -137,3 +137,144 @@ func TestFinalizeState_OK(t *testing.T) {
 		t.Error("Retrieved and saved finalized are unequal")
 	}
 }
+
+func TestSaveStateForBlock_FollowsPolicy(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	boundary := &pb.BeaconState{Slot: params.BeaconConfig().GenesisSlot + params.BeaconConfig().SlotsPerEpoch}
+	midEpoch := &pb.BeaconState{Slot: boundary.Slot + 1}
+	boundaryRoot, midEpochRoot := [32]byte{'a'}, [32]byte{'b'}
+
+	tests := []struct {
+		root   [32]byte
+		state  *pb.BeaconState
+		stored bool
+	}{
+		{root: boundaryRoot, state: boundary, stored: true},
+		{root: midEpochRoot, state: midEpoch, stored: false},
+	}
+	for _, tt := range tests {
+		stored, err := db.SaveStateForBlock(tt.root, tt.state)
+		if err != nil {
+			t.Fatalf("Could not save state: %v", err)
+		}
+		if stored != tt.stored {
+			t.Errorf("Expected state at slot %d stored to be %v", tt.state.Slot, tt.stored)
+		}
+		if db.HasStateForBlock(tt.root) != tt.stored {
+			t.Errorf("Expected state for block %#x to exist: %v", tt.root, tt.stored)
+		}
+	}
+
+	saved, err := db.StateByRoot(boundaryRoot)
+	if err != nil {
+		t.Fatalf("Could not retrieve state: %v", err)
+	}
+	if !proto.Equal(saved, boundary) {
+		t.Errorf("Expected state %v, received %v", boundary, saved)
+	}
+	missing, err := db.StateByRoot(midEpochRoot)
+	if err != nil {
+		t.Fatalf("Could not retrieve state: %v", err)
+	}
+	if missing != nil {
+		t.Errorf("Expected no state for a mid-epoch block, received %v", missing)
+	}
+
+	db.SetStateStoragePolicy(StoreAllStates)
+	if stored, err := db.SaveStateForBlock(midEpochRoot, midEpoch); err != nil || !stored {
+		t.Errorf("Expected state to be stored with every state being kept, stored %v: %v", stored, err)
+	}
+	if err := db.DeleteStateForBlock(midEpochRoot); err != nil {
+		t.Fatalf("Could not delete state: %v", err)
+	}
+	if db.HasStateForBlock(midEpochRoot) {
+		t.Error("Expected state to be deleted")
+	}
+}
+
+func TestHeadState_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	genesisTime := uint64(time.Now().Unix())
+	deposits, _ := setupInitialDeposits(t, 10)
+	if err := db.InitializeState(genesisTime, deposits, &pb.Eth1Data{}); err != nil {
+		t.Fatalf("Failed to initialize state: %v", err)
+	}
+	genesisState, err := db.State(ctx)
+	if err != nil {
+		t.Fatalf("Failed to retrieve state: %v", err)
+	}
+	headState, err := db.HeadState(ctx)
+	if err != nil {
+		t.Fatalf("Failed to retrieve head state: %v", err)
+	}
+	if !proto.Equal(headState, genesisState) {
+		t.Error("Expected head state to be the genesis state")
+	}
+
+	// A head without a stored block state falls back to the canonical state.
+	block := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 1}
+	if err := db.SaveBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	newState := &pb.BeaconState{Slot: block.Slot}
+	if err := db.UpdateChainHead(block, newState); err != nil {
+		t.Fatal(err)
+	}
+	headState, err = db.HeadState(ctx)
+	if err != nil {
+		t.Fatalf("Failed to retrieve head state: %v", err)
+	}
+	if !proto.Equal(headState, newState) {
+		t.Errorf("Expected head state %v, received %v", newState, headState)
+	}
+}
+
+func TestInitializeStateFromAnchor_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	anchor := &pb.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 10,
+		ParentRootHash32: []byte{'A'},
+	}
+	anchorState := &pb.BeaconState{
+		Slot:              anchor.Slot,
+		ValidatorRegistry: []*pb.Validator{{Pubkey: []byte{'B'}}},
+	}
+	if err := db.InitializeStateFromAnchor(anchor, anchorState); err != nil {
+		t.Fatalf("Failed to initialize state from anchor: %v", err)
+	}
+
+	head, err := db.ChainHead()
+	if err != nil {
+		t.Fatalf("Failed to get chain head: %v", err)
+	}
+	if !proto.Equal(head, anchor) {
+		t.Errorf("Expected chain head %v, received %v", anchor, head)
+	}
+	for name, fetch := range map[string]func() (*pb.BeaconState, error){
+		"head":      func() (*pb.BeaconState, error) { return db.State(ctx) },
+		"finalized": db.FinalizedState,
+	} {
+		beaconState, err := fetch()
+		if err != nil {
+			t.Fatalf("Failed to get %s state: %v", name, err)
+		}
+		if !proto.Equal(beaconState, anchorState) {
+			t.Errorf("Expected %s state to be the anchor state, received %v", name, beaconState)
+		}
+	}
+	if !db.HasValidator([]byte{'B'}) {
+		t.Error("Expected anchor state validators to be indexed")
+	}
+
+	if err := db.InitializeStateFromAnchor(anchor, anchorState); err == nil {
+		t.Error("Expected initializing a DB which holds a chain to fail")
+	}
+}
@@ -0,0 +1,44 @@
+package db
+
+// Store is the transactional key-value engine persisting the buckets of a BeaconDB.
+// Any engine implementing it can back the beacon chain data layer, the node uses a
+// bolt database while tests and simulations can run on an in-memory store.
+type Store interface {
+	// Update runs fn in a read-write transaction. Its changes are committed if fn returns
+	// nil and discarded otherwise.
+	Update(fn func(Tx) error) error
+	// Batch behaves like Update, but may combine concurrent calls into a single transaction,
+	// in which case fn can be called more than once.
+	Batch(fn func(Tx) error) error
+	// View runs fn in a read-only transaction.
+	View(fn func(Tx) error) error
+	// Close releases the resources held by the store.
+	Close() error
+}
+
+// Tx is a transaction of a Store.
+type Tx interface {
+	// Bucket returns the bucket with the given name, or nil if it does not exist.
+	Bucket(name []byte) Bucket
+	// CreateBucketIfNotExists creates the bucket with the given name if needed and returns it.
+	CreateBucketIfNotExists(name []byte) (Bucket, error)
+}
+
+// Bucket is a collection of key-value pairs within a transaction. Slices returned by a
+// bucket are only valid for the life of the transaction, and slices given to it must not
+// be modified until the transaction ends.
+type Bucket interface {
+	// Get returns the value of a key, or nil if the key does not exist.
+	Get(key []byte) []byte
+	// Put sets the value of a key.
+	Put(key []byte, value []byte) error
+	// Delete removes a key. Deleting a key which does not exist does nothing.
+	Delete(key []byte) error
+	// ForEach calls fn for every key-value pair of the bucket in ascending key order.
+	// The bucket must not be modified while iterating over it.
+	ForEach(fn func(k []byte, v []byte) error) error
+	// ForEachInRange calls fn for every key-value pair whose key is at least start and lower
+	// than end, in ascending key order. A nil end leaves the range unbounded. The bucket must
+	// not be modified while iterating over it.
+	ForEachInRange(start []byte, end []byte, fn func(k []byte, v []byte) error) error
+}
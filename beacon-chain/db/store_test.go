@@ -0,0 +1,219 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+var testBucket = []byte("test-bucket")
+
+// testStores returns every store implementation, along with the directory of the bolt
+// store which the caller has to remove.
+func testStores(t *testing.T) (map[string]Store, string) {
+	dir := fmt.Sprintf("%s/store-%s", testutil.TempDir(), t.Name())
+	boltStore, err := NewBoltStore(dir)
+	if err != nil {
+		t.Fatalf("Could not open bolt store: %v", err)
+	}
+	return map[string]Store{
+		"bolt":   boltStore,
+		"memory": NewMemoryStore(),
+	}, dir
+}
+
+func TestStore_UpdateCommitsOnSuccess(t *testing.T) {
+	stores, dir := testStores(t)
+	defer os.RemoveAll(dir)
+	for name, store := range stores {
+		if err := store.Update(func(tx Tx) error {
+			bucket, err := tx.CreateBucketIfNotExists(testBucket)
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put([]byte("a"), []byte("1")); err != nil {
+				return err
+			}
+			// Writes are visible within the transaction.
+			if !bytes.Equal(bucket.Get([]byte("a")), []byte("1")) {
+				return errors.New("could not read own write")
+			}
+			return nil
+		}); err != nil {
+			t.Fatalf("%s: could not update store: %v", name, err)
+		}
+
+		if err := store.View(func(tx Tx) error {
+			if tx.Bucket([]byte("missing")) != nil {
+				t.Errorf("%s: expected missing bucket to be nil", name)
+			}
+			if v := tx.Bucket(testBucket).Get([]byte("a")); !bytes.Equal(v, []byte("1")) {
+				t.Errorf("%s: expected committed value 1, received %q", name, v)
+			}
+			return nil
+		}); err != nil {
+			t.Fatalf("%s: could not view store: %v", name, err)
+		}
+		if err := store.Close(); err != nil {
+			t.Fatalf("%s: could not close store: %v", name, err)
+		}
+	}
+}
+
+func TestStore_UpdateDiscardsOnError(t *testing.T) {
+	stores, dir := testStores(t)
+	defer os.RemoveAll(dir)
+	for name, store := range stores {
+		if err := store.Update(func(tx Tx) error {
+			bucket, err := tx.CreateBucketIfNotExists(testBucket)
+			if err != nil {
+				return err
+			}
+			return bucket.Put([]byte("a"), []byte("1"))
+		}); err != nil {
+			t.Fatalf("%s: could not update store: %v", name, err)
+		}
+
+		wanted := errors.New("rollback")
+		if err := store.Update(func(tx Tx) error {
+			bucket := tx.Bucket(testBucket)
+			if err := bucket.Put([]byte("b"), []byte("2")); err != nil {
+				return err
+			}
+			if err := bucket.Delete([]byte("a")); err != nil {
+				return err
+			}
+			return wanted
+		}); err != wanted {
+			t.Fatalf("%s: expected error %v, received %v", name, wanted, err)
+		}
+
+		if err := store.View(func(tx Tx) error {
+			bucket := tx.Bucket(testBucket)
+			if bucket.Get([]byte("a")) == nil {
+				t.Errorf("%s: expected deleted key to be restored", name)
+			}
+			if bucket.Get([]byte("b")) != nil {
+				t.Errorf("%s: expected written key to be discarded", name)
+			}
+			return nil
+		}); err != nil {
+			t.Fatalf("%s: could not view store: %v", name, err)
+		}
+		if err := store.Close(); err != nil {
+			t.Fatalf("%s: could not close store: %v", name, err)
+		}
+	}
+}
+
+func TestStore_ForEachIsOrdered(t *testing.T) {
+	stores, dir := testStores(t)
+	defer os.RemoveAll(dir)
+	for name, store := range stores {
+		if err := store.Update(func(tx Tx) error {
+			bucket, err := tx.CreateBucketIfNotExists(testBucket)
+			if err != nil {
+				return err
+			}
+			for _, k := range []string{"c", "a", "d"} {
+				if err := bucket.Put([]byte(k), []byte(k)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			t.Fatalf("%s: could not update store: %v", name, err)
+		}
+
+		if err := store.Update(func(tx Tx) error {
+			bucket := tx.Bucket(testBucket)
+			if err := bucket.Put([]byte("b"), []byte("b")); err != nil {
+				return err
+			}
+			if err := bucket.Delete([]byte("d")); err != nil {
+				return err
+			}
+			var keys []byte
+			if err := bucket.ForEach(func(k, v []byte) error {
+				keys = append(keys, k...)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if string(keys) != "abc" {
+				t.Errorf("%s: expected keys abc, received %s", name, keys)
+			}
+			return nil
+		}); err != nil {
+			t.Fatalf("%s: could not update store: %v", name, err)
+		}
+		if err := store.Close(); err != nil {
+			t.Fatalf("%s: could not close store: %v", name, err)
+		}
+	}
+}
+
+func TestStore_ForEachInRange(t *testing.T) {
+	stores, dir := testStores(t)
+	defer os.RemoveAll(dir)
+	for name, store := range stores {
+		if err := store.Update(func(tx Tx) error {
+			bucket, err := tx.CreateBucketIfNotExists(testBucket)
+			if err != nil {
+				return err
+			}
+			for _, k := range []string{"a", "ba", "bb", "c"} {
+				if err := bucket.Put([]byte(k), []byte(k)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			t.Fatalf("%s: could not update store: %v", name, err)
+		}
+
+		for _, tt := range []struct {
+			start, end []byte
+			want       string
+		}{
+			{start: []byte("b"), end: []byte("c"), want: "babb"},
+			{start: []byte("bb"), end: nil, want: "bbc"},
+			{start: nil, end: []byte("b"), want: "a"},
+		} {
+			if err := store.View(func(tx Tx) error {
+				var keys []byte
+				if err := tx.Bucket(testBucket).ForEachInRange(tt.start, tt.end, func(k, v []byte) error {
+					keys = append(keys, k...)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if string(keys) != tt.want {
+					t.Errorf("%s: expected keys %s in [%s, %s), received %s", name, tt.want, tt.start, tt.end, keys)
+				}
+				return nil
+			}); err != nil {
+				t.Fatalf("%s: could not view store: %v", name, err)
+			}
+		}
+		if err := store.Close(); err != nil {
+			t.Fatalf("%s: could not close store: %v", name, err)
+		}
+	}
+}
+
+func TestMemoryStore_ViewIsReadOnly(t *testing.T) {
+	db, err := NewDBWithStore(NewMemoryStore())
+	if err != nil {
+		t.Fatalf("Could not create DB: %v", err)
+	}
+	if err := db.view(func(tx Tx) error {
+		return tx.Bucket(blockBucket).Put([]byte("a"), []byte("1"))
+	}); err != errTxNotWritable {
+		t.Errorf("Expected %v, received %v", errTxNotWritable, err)
+	}
+}
@@ -5,7 +5,7 @@ import (
 	"encoding/binary"
 	"fmt"
 
-	"github.com/boltdb/bolt"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 )
 
@@ -13,7 +13,7 @@ import (
 func (db *BeaconDB) SaveValidatorIndex(pubKey []byte, index int) error {
 	h := hashutil.Hash(pubKey)
 
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx Tx) error {
 		bucket := tx.Bucket(validatorBucket)
 
 		buf := make([]byte, binary.MaxVarintLen64)
@@ -27,7 +27,7 @@ func (db *BeaconDB) SaveValidatorIndex(pubKey []byte, index int) error {
 func (db *BeaconDB) SaveValidatorIndexBatch(pubKey []byte, index int) error {
 	h := hashutil.Hash(pubKey)
 
-	return db.batch(func(tx *bolt.Tx) error {
+	return db.batch(func(tx Tx) error {
 		bucket := tx.Bucket(validatorBucket)
 		buf := make([]byte, binary.MaxVarintLen64)
 		n := binary.PutUvarint(buf, uint64(index))
@@ -45,7 +45,7 @@ func (db *BeaconDB) ValidatorIndex(pubKey []byte) (uint64, error) {
 	var index uint64
 	h := hashutil.Hash(pubKey)
 
-	err := db.view(func(tx *bolt.Tx) error {
+	err := db.view(func(tx Tx) error {
 		bucket := tx.Bucket(validatorBucket)
 
 		enc := bucket.Get(h[:])
@@ -65,7 +65,7 @@ func (db *BeaconDB) ValidatorIndex(pubKey []byte) (uint64, error) {
 func (db *BeaconDB) DeleteValidatorIndex(pubKey []byte) error {
 	h := hashutil.Hash(pubKey)
 
-	return db.update(func(tx *bolt.Tx) error {
+	return db.update(func(tx Tx) error {
 		a := tx.Bucket(validatorBucket)
 
 		return a.Delete(h[:])
@@ -77,7 +77,7 @@ func (db *BeaconDB) HasValidator(pubKey []byte) bool {
 	exists := false
 	h := hashutil.Hash(pubKey)
 	// #nosec G104, similar to HasBlock, HasAttestation... etc
-	db.view(func(tx *bolt.Tx) error {
+	db.view(func(tx Tx) error {
 		a := tx.Bucket(validatorBucket)
 
 		exists = a.Get(h[:]) != nil
@@ -85,3 +85,68 @@ func (db *BeaconDB) HasValidator(pubKey []byte) bool {
 	})
 	return exists
 }
+
+// RebuildValidatorIndex replaces every public key to index record with the validator
+// registry of the given state. It is used on startup, so that the index always reflects
+// the state of the chain head.
+func (db *BeaconDB) RebuildValidatorIndex(beaconState *pb.BeaconState) error {
+	return db.update(func(tx Tx) error {
+		return rebuildValidatorIndex(tx, beaconState.ValidatorRegistry)
+	})
+}
+
+// rebuildValidatorIndex clears the validator bucket and fills it again from the registry.
+func rebuildValidatorIndex(tx Tx, registry []*pb.Validator) error {
+	bucket := tx.Bucket(validatorBucket)
+	var keys [][]byte
+	if err := bucket.ForEach(func(k, _ []byte) error {
+		keys = append(keys, k)
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := bucket.Delete(k); err != nil {
+			return fmt.Errorf("failed to clear validator index: %v", err)
+		}
+	}
+	return updateValidatorIndex(tx, registry)
+}
+
+// updateValidatorIndex records the index of every validator in the registry, only writing
+// the records which changed. Indices in the registry never change along a chain, so this
+// is enough to follow the head as long as it does not switch to another branch.
+func updateValidatorIndex(tx Tx, registry []*pb.Validator) error {
+	bucket := tx.Bucket(validatorBucket)
+	for i, validator := range registry {
+		h := hashutil.Hash(validator.Pubkey)
+		buf := make([]byte, binary.MaxVarintLen64)
+		n := binary.PutUvarint(buf, uint64(i))
+		if bytes.Equal(bucket.Get(h[:]), buf[:n]) {
+			continue
+		}
+		if err := bucket.Put(h[:], buf[:n]); err != nil {
+			return fmt.Errorf("failed to record index of validator %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// ValidatorIndices returns every record of the validator index, keyed by the hash of the
+// validator public key.
+func (db *BeaconDB) ValidatorIndices() (map[[32]byte]uint64, error) {
+	indices := make(map[[32]byte]uint64)
+	err := db.view(func(tx Tx) error {
+		return tx.Bucket(validatorBucket).ForEach(func(k, v []byte) error {
+			index, err := binary.ReadUvarint(bytes.NewBuffer(v))
+			if err != nil {
+				return fmt.Errorf("could not decode index of validator %#x: %v", k, err)
+			}
+			var h [32]byte
+			copy(h[:], k)
+			indices[h] = index
+			return nil
+		})
+	})
+	return indices, err
+}
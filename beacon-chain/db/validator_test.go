@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
 
 func TestSaveAndRetrieveValidatorIndex_OK(t *testing.T) {
@@ -63,3 +65,56 @@ func TestSaveAndDeleteValidatorIndex_OK(t *testing.T) {
 		t.Errorf("Want: %v, got: %v", want, err.Error())
 	}
 }
+
+func TestUpdateChainHead_IndexesValidators(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	block := &pb.BeaconBlock{Slot: 1}
+	if err := db.SaveBlock(block); err != nil {
+		t.Fatalf("Failed to save block: %v", err)
+	}
+	beaconState := &pb.BeaconState{
+		ValidatorRegistry: []*pb.Validator{{Pubkey: []byte{'A'}}, {Pubkey: []byte{'B'}}},
+	}
+	if err := db.UpdateChainHead(block, beaconState); err != nil {
+		t.Fatalf("Failed to update chain head: %v", err)
+	}
+
+	for i, validator := range beaconState.ValidatorRegistry {
+		index, err := db.ValidatorIndex(validator.Pubkey)
+		if err != nil {
+			t.Fatalf("Failed to get validator index: %v", err)
+		}
+		if index != uint64(i) {
+			t.Errorf("Wanted index %d, got %d", i, index)
+		}
+	}
+}
+
+func TestRebuildValidatorIndex_DropsStaleRecords(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	stale := []byte{'A'}
+	if err := db.SaveValidatorIndex(stale, 0); err != nil {
+		t.Fatalf("Failed to save validator index: %v", err)
+	}
+	beaconState := &pb.BeaconState{
+		ValidatorRegistry: []*pb.Validator{{Pubkey: []byte{'B'}}},
+	}
+	if err := db.RebuildValidatorIndex(beaconState); err != nil {
+		t.Fatalf("Failed to rebuild validator index: %v", err)
+	}
+
+	if db.HasValidator(stale) {
+		t.Error("Expected validator missing from the state to be removed from the index")
+	}
+	index, err := db.ValidatorIndex([]byte{'B'})
+	if err != nil {
+		t.Fatalf("Failed to get validator index: %v", err)
+	}
+	if index != 0 {
+		t.Errorf("Wanted index 0, got %d", index)
+	}
+}
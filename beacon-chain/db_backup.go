@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/node"
+	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/tlsutil"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var dbCommand = cli.Command{
+	Name:  "db",
+	Usage: "Manage the beacon chain database",
+	Subcommands: []cli.Command{
+		{
+			Name:      "backup",
+			Usage:     "Copy the database of a running beacon node, started with --enable-admin-rpc, into a backup file",
+			ArgsUsage: "<backup file>",
+			Flags: []cli.Flag{
+				utils.RPCEndpointFlag,
+				utils.CertFlag,
+				utils.ClientCertFlag,
+				utils.ClientKeyFlag,
+			},
+			Action: backupDB,
+		},
+		{
+			Name:      "restore",
+			Usage:     "Restore a backup file into the database of the data directory",
+			ArgsUsage: "<backup file>",
+			Action:    restoreDB,
+		},
+	},
+}
+
+// backupDB streams the DB of the running node into the backup file. The metadata of the
+// backup is written next to it, in the backup file name suffixed with .json.
+func backupDB(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.New("expected the path of the backup file as the only argument")
+	}
+	backupFile := ctx.Args().First()
+
+	endpoint := ctx.String(utils.RPCEndpointFlag.Name)
+	dialOpt := grpc.WithInsecure()
+	if cert := ctx.String(utils.CertFlag.Name); cert != "" {
+		reloader, err := tlsutil.NewReloader(ctx.String(utils.ClientCertFlag.Name), ctx.String(utils.ClientKeyFlag.Name), cert)
+		if err != nil {
+			return fmt.Errorf("could not get valid credentials: %v", err)
+		}
+		dialOpt = grpc.WithTransportCredentials(credentials.NewTLS(reloader.ClientConfig(endpoint)))
+	}
+	conn, err := grpc.Dial(endpoint, dialOpt)
+	if err != nil {
+		return fmt.Errorf("could not dial endpoint %s: %v", endpoint, err)
+	}
+	defer conn.Close()
+
+	stream, err := pb.NewAdminServiceClient(conn).Backup(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		return fmt.Errorf("could not request backup: %v", err)
+	}
+	file, err := os.Create(backupFile)
+	if err != nil {
+		return fmt.Errorf("could not create backup file: %v", err)
+	}
+	metadata, err := receiveBackup(stream, file)
+	if err != nil {
+		// #nosec G104
+		file.Close()
+		// #nosec G104
+		os.Remove(backupFile)
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("could not close backup file: %v", err)
+	}
+
+	enc, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode backup metadata: %v", err)
+	}
+	if err := ioutil.WriteFile(backupFile+".json", enc, 0600); err != nil {
+		return fmt.Errorf("could not write backup metadata: %v", err)
+	}
+	logrus.WithFields(logrus.Fields{
+		"prefix":         "main",
+		"file":           backupFile,
+		"headSlot":       metadata.HeadSlot,
+		"finalizedEpoch": metadata.FinalizedEpoch,
+		"schemaVersion":  metadata.SchemaVersion,
+	}).Info("Backed up DB")
+	return nil
+}
+
+// receiveBackup writes the DB chunks of the stream to w, and returns the metadata carried
+// by the last chunk.
+func receiveBackup(stream pb.AdminService_BackupClient, w io.Writer) (*pb.BackupMetadata, error) {
+	var size uint64
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil, errors.New("backup stream ended before its metadata")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not receive backup: %v", err)
+		}
+		if _, err := w.Write(chunk.Data); err != nil {
+			return nil, fmt.Errorf("could not write backup file: %v", err)
+		}
+		size += uint64(len(chunk.Data))
+		if chunk.Metadata != nil {
+			if chunk.Metadata.SizeBytes != size {
+				return nil, fmt.Errorf("received %d bytes of a backup of %d bytes", size, chunk.Metadata.SizeBytes)
+			}
+			return chunk.Metadata, nil
+		}
+	}
+}
+
+// restoreDB restores a backup file into the data directory, where a node can then be started.
+func restoreDB(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.New("expected the path of the backup file as the only argument")
+	}
+	file, err := os.Open(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("could not open backup file: %v", err)
+	}
+	defer file.Close()
+
+	dbPath := node.DBPath(ctx)
+	if err := db.RestoreBackup(dbPath, file); err != nil {
+		return fmt.Errorf("could not restore backup: %v", err)
+	}
+	logrus.WithFields(logrus.Fields{
+		"prefix": "main",
+		"file":   ctx.Args().First(),
+		"db":     dbPath,
+	}).Info("Restored DB")
+	return nil
+}
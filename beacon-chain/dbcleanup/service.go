@@ -0,0 +1,143 @@
+// Package dbcleanup defines the life-cycle and logic of the beacon DB cleanup routine,
+// which prunes data that is no longer needed once a slot is finalized.
+package dbcleanup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "dbcleaner")
+
+type chainService interface {
+	CheckpointFeed() *event.Feed
+}
+
+// CleanupService represents a service that prunes the beacon DB every time the chain
+// finalizes a new epoch. It deletes blocks which can no longer become canonical, pooled
+// attestations, exits and slashings which can no longer be included, and stored states
+// which are superseded by the states kept at epoch boundaries.
+type CleanupService struct {
+	ctx            context.Context
+	cancel         context.CancelFunc
+	beaconDB       *db.BeaconDB
+	chainService   chainService
+	checkpointChan chan *blockchain.CheckpointUpdate
+}
+
+// Config options for the service.
+type Config struct {
+	SubscriptionBuf int
+	BeaconDB        *db.BeaconDB
+	ChainService    chainService
+}
+
+// NewCleanupService instantiates a new service instance that will
+// be registered into a running beacon node.
+func NewCleanupService(ctx context.Context, cfg *Config) *CleanupService {
+	ctx, cancel := context.WithCancel(ctx)
+	return &CleanupService{
+		ctx:            ctx,
+		cancel:         cancel,
+		beaconDB:       cfg.BeaconDB,
+		chainService:   cfg.ChainService,
+		checkpointChan: make(chan *blockchain.CheckpointUpdate, cfg.SubscriptionBuf),
+	}
+}
+
+// Start a DB cleanup service's main event loop.
+func (d *CleanupService) Start() {
+	log.Info("Starting service")
+	go d.cleanDB()
+}
+
+// Stop the DB cleanup service's main event loop and associated goroutines.
+func (d *CleanupService) Stop() error {
+	defer d.cancel()
+	log.Info("Stopping service")
+	return nil
+}
+
+// Status always returns nil.
+func (d *CleanupService) Status() error {
+	return nil
+}
+
+func (d *CleanupService) cleanDB() {
+	checkpointSub := d.chainService.CheckpointFeed().Subscribe(d.checkpointChan)
+	defer checkpointSub.Unsubscribe()
+
+	for {
+		select {
+		case <-d.ctx.Done():
+			log.Debug("Cleanup service context closed, exiting goroutine")
+			return
+		case update := <-d.checkpointChan:
+			if update.FinalizedEpoch == update.OldFinalizedEpoch {
+				continue
+			}
+			if err := d.cleanupFinalizedData(update.FinalizedEpoch); err != nil {
+				log.Errorf("Failed to clean up DB after finalization: %v", err)
+			}
+		}
+	}
+}
+
+// cleanupFinalizedData prunes the DB up to the start slot of the given finalized epoch.
+// Nothing is done if that slot was already cleaned up.
+func (d *CleanupService) cleanupFinalizedData(finalizedEpoch uint64) error {
+	lastCleanedSlot, err := d.beaconDB.CleanedFinalizedSlot()
+	if err != nil {
+		return fmt.Errorf("failed to read cleanup history: %v", err)
+	}
+	finalizedSlot := helpers.StartSlot(finalizedEpoch)
+	if finalizedSlot <= lastCleanedSlot {
+		return nil
+	}
+
+	finalizedState, err := d.beaconDB.FinalizedState()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve finalized state: %v", err)
+	}
+
+	blocks, err := d.beaconDB.DeleteNonCanonicalBlocks(finalizedSlot)
+	if err != nil {
+		return fmt.Errorf("failed to delete non-canonical blocks: %v", err)
+	}
+	states, err := d.beaconDB.DeleteSupersededStates(finalizedSlot)
+	if err != nil {
+		return fmt.Errorf("failed to delete superseded states: %v", err)
+	}
+	attestations, err := d.beaconDB.DeleteAttestationsBefore(finalizedSlot)
+	if err != nil {
+		return fmt.Errorf("failed to delete stale attestations: %v", err)
+	}
+	exits, err := d.beaconDB.DeleteExitsOf(finalizedState)
+	if err != nil {
+		return fmt.Errorf("failed to delete stale exits: %v", err)
+	}
+	slashings, err := d.beaconDB.DeleteSlashingsOf(finalizedState)
+	if err != nil {
+		return fmt.Errorf("failed to delete stale slashings: %v", err)
+	}
+
+	if err := d.beaconDB.SaveCleanedFinalizedSlot(finalizedSlot); err != nil {
+		return fmt.Errorf("failed to record cleanup history: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"finalizedSlot":       finalizedSlot - params.BeaconConfig().GenesisSlot,
+		"deletedBlocks":       blocks,
+		"deletedStates":       states,
+		"deletedAttestations": attestations,
+		"deletedExits":        exits,
+		"deletedSlashings":    slashings,
+	}).Info("Cleaned up finalized DB data")
+	return nil
+}
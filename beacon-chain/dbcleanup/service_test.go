@@ -0,0 +1,195 @@
+package dbcleanup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+type mockChainService struct {
+	checkpointFeed event.Feed
+}
+
+func (m *mockChainService) CheckpointFeed() *event.Feed {
+	return &m.checkpointFeed
+}
+
+func TestLifecycle_OK(t *testing.T) {
+	hook := logTest.NewGlobal()
+	cleanupService := NewCleanupService(context.Background(), &Config{ChainService: &mockChainService{}})
+
+	cleanupService.Start()
+	testutil.AssertLogsContain(t, hook, "Starting service")
+
+	if err := cleanupService.Stop(); err != nil {
+		t.Fatalf("Unable to stop cleanup service: %v", err)
+	}
+	testutil.AssertLogsContain(t, hook, "Stopping service")
+
+	// The context should have been canceled.
+	if cleanupService.ctx.Err() != context.Canceled {
+		t.Error("Context was not canceled")
+	}
+}
+
+func TestCleanupFinalizedData_PrunesAndRecordsProgress(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	cleanupService := NewCleanupService(context.Background(), &Config{
+		BeaconDB:     beaconDB,
+		ChainService: &mockChainService{},
+	})
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	genesis := &pb.BeaconBlock{Slot: genesisSlot}
+	genesisRoot, err := hashutil.HashBeaconBlock(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	canonical := &pb.BeaconBlock{Slot: genesisSlot + 1, ParentRootHash32: genesisRoot[:]}
+	orphaned := &pb.BeaconBlock{Slot: genesisSlot + 1, ParentRootHash32: genesisRoot[:], StateRootHash32: []byte{'a'}}
+	for _, block := range []*pb.BeaconBlock{genesis, canonical, orphaned} {
+		if err := beaconDB.SaveBlock(block); err != nil {
+			t.Fatal(err)
+		}
+	}
+	finalizedState := &pb.BeaconState{
+		Slot:           genesisSlot + params.BeaconConfig().SlotsPerEpoch,
+		FinalizedEpoch: params.BeaconConfig().GenesisEpoch + 1,
+		ValidatorRegistry: []*pb.Validator{{
+			ExitEpoch:    params.BeaconConfig().GenesisEpoch,
+			SlashedEpoch: params.BeaconConfig().GenesisEpoch,
+		}},
+	}
+	for _, block := range []*pb.BeaconBlock{genesis, canonical} {
+		if err := beaconDB.UpdateChainHead(block, finalizedState); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := beaconDB.SaveFinalizedState(finalizedState); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveAttestation(&pb.Attestation{Data: &pb.AttestationData{Slot: genesisSlot}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveExit(&pb.VoluntaryExit{ValidatorIndex: 0}); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveProposerSlashing(&pb.ProposerSlashing{ProposerIndex: 0}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cleanupService.cleanupFinalizedData(finalizedState.FinalizedEpoch); err != nil {
+		t.Fatalf("Could not clean up DB: %v", err)
+	}
+
+	orphanedRoot, err := hashutil.HashBeaconBlock(orphaned)
+	if err != nil {
+		t.Fatal(err)
+	}
+	canonicalRoot, err := hashutil.HashBeaconBlock(canonical)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beaconDB.HasBlock(orphanedRoot) {
+		t.Error("Expected orphaned block to be deleted")
+	}
+	if !beaconDB.HasBlock(canonicalRoot) {
+		t.Error("Expected canonical block to be kept")
+	}
+	attestations, err := beaconDB.Attestations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attestations) != 0 {
+		t.Errorf("Expected stale attestations to be deleted, %d remain", len(attestations))
+	}
+	exit, err := hashutil.HashProto(&pb.VoluntaryExit{ValidatorIndex: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beaconDB.HasExit(exit) {
+		t.Error("Expected exit of an exited validator to be deleted")
+	}
+	slashing, err := hashutil.HashProto(&pb.ProposerSlashing{ProposerIndex: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beaconDB.HasProposerSlashing(slashing) {
+		t.Error("Expected slashing of a slashed validator to be deleted")
+	}
+
+	cleanedSlot, err := beaconDB.CleanedFinalizedSlot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cleanedSlot != finalizedState.Slot {
+		t.Errorf("Expected cleaned finalized slot %d, received %d", finalizedState.Slot, cleanedSlot)
+	}
+	testutil.AssertLogsContain(t, hook, "Cleaned up finalized DB data")
+
+	// Cleaning up the same finalized slot again does nothing.
+	hook.Reset()
+	if err := cleanupService.cleanupFinalizedData(finalizedState.FinalizedEpoch); err != nil {
+		t.Fatalf("Could not clean up DB: %v", err)
+	}
+	testutil.AssertLogsDoNotContain(t, hook, "Cleaned up finalized DB data")
+}
+
+func TestCleanDB_CleansUpOnCheckpointUpdate(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	chainService := &mockChainService{}
+	cleanupService := NewCleanupService(context.Background(), &Config{
+		BeaconDB:     beaconDB,
+		ChainService: chainService,
+	})
+
+	finalizedState := &pb.BeaconState{
+		Slot:           params.BeaconConfig().GenesisSlot + params.BeaconConfig().SlotsPerEpoch,
+		FinalizedEpoch: params.BeaconConfig().GenesisEpoch + 1,
+	}
+	if err := beaconDB.SaveFinalizedState(finalizedState); err != nil {
+		t.Fatal(err)
+	}
+
+	exitRoutine := make(chan bool)
+	go func() {
+		cleanupService.cleanDB()
+		<-exitRoutine
+	}()
+
+	// Wait for the cleanup routine to subscribe before sending the update.
+	update := &blockchain.CheckpointUpdate{
+		OldFinalizedEpoch: params.BeaconConfig().GenesisEpoch,
+		FinalizedEpoch:    finalizedState.FinalizedEpoch,
+	}
+	for chainService.CheckpointFeed().Send(update) == 0 {
+	}
+	// The feed is unbuffered, so this update is only received once the previous one was handled.
+	chainService.CheckpointFeed().Send(&blockchain.CheckpointUpdate{
+		OldFinalizedEpoch: finalizedState.FinalizedEpoch,
+		FinalizedEpoch:    finalizedState.FinalizedEpoch,
+	})
+	cleanupService.cancel()
+	exitRoutine <- true
+
+	cleanedSlot, err := beaconDB.CleanedFinalizedSlot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cleanedSlot != finalizedState.Slot {
+		t.Errorf("Expected cleaned finalized slot %d, received %d", finalizedState.Slot, cleanedSlot)
+	}
+	testutil.AssertLogsContain(t, hook, "Cleaned up finalized DB data")
+}
@@ -0,0 +1,263 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
+)
+
+// The JSON encoding of the messages follows the proto3 JSON mapping, except for bytes fields
+// which are 0x-prefixed hex strings instead of base64 ones. Fields are named as in the proto
+// definitions, 64-bit integers are decimal strings, enums are their value names and
+// timestamps are RFC 3339 strings.
+
+var (
+	timestampType = reflect.TypeOf(ptypes.Timestamp{})
+	bytesType     = reflect.TypeOf([]byte{})
+)
+
+// marshalJSON encodes a message into JSON.
+func marshalJSON(msg proto.Message) ([]byte, error) {
+	return json.Marshal(jsonValue(reflect.ValueOf(msg), ""))
+}
+
+// unmarshalJSON decodes JSON into a message. An empty input leaves the message unset.
+func unmarshalJSON(data []byte, msg proto.Message) error {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return fmt.Errorf("could not decode JSON: %v", err)
+	}
+	return setValue(reflect.ValueOf(msg).Elem(), v, "", "")
+}
+
+// jsonValue returns the value to encode into JSON for a message field. The enum name is set
+// for enum fields, as found in their protobuf tag.
+func jsonValue(v reflect.Value, enum string) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Type().Elem() == timestampType {
+			t, err := ptypes.TimestampFromProto(v.Interface().(*ptypes.Timestamp))
+			if err != nil {
+				return nil
+			}
+			return t.Format(time.RFC3339Nano)
+		}
+		return jsonValue(v.Elem(), enum)
+	case reflect.Struct:
+		fields := make(map[string]interface{})
+		for i := 0; i < v.NumField(); i++ {
+			name, fieldEnum, ok := protoField(v.Type().Field(i))
+			if ok {
+				fields[name] = jsonValue(v.Field(i), fieldEnum)
+			}
+		}
+		return fields
+	case reflect.Slice:
+		if v.Type() == bytesType {
+			return fmt.Sprintf("%#x", v.Bytes())
+		}
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = jsonValue(v.Index(i), enum)
+		}
+		return items
+	case reflect.Int32:
+		if name, ok := v.Interface().(fmt.Stringer); ok && enum != "" {
+			return name.String()
+		}
+		return v.Int()
+	case reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	default:
+		return v.Interface()
+	}
+}
+
+// setValue sets a message field from its decoded JSON value. The path of the field is used
+// in the returned errors.
+func setValue(v reflect.Value, jsonVal interface{}, enum string, path string) error {
+	if jsonVal == nil {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.Type().Elem() == timestampType {
+			s, ok := jsonVal.(string)
+			if !ok {
+				return fmt.Errorf("expected a timestamp string for %s", path)
+			}
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return fmt.Errorf("invalid timestamp for %s: %v", path, err)
+			}
+			ts, err := ptypes.TimestampProto(t)
+			if err != nil {
+				return fmt.Errorf("invalid timestamp for %s: %v", path, err)
+			}
+			v.Set(reflect.ValueOf(ts))
+			return nil
+		}
+		elem := reflect.New(v.Type().Elem())
+		if err := setValue(elem.Elem(), jsonVal, enum, path); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	case reflect.Struct:
+		fields, ok := jsonVal.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected an object for %s", pathOrRoot(path))
+		}
+		for key, fieldVal := range fields {
+			i, fieldEnum, ok := structField(v.Type(), key)
+			if !ok {
+				return fmt.Errorf("unknown field %s", joinPath(path, key))
+			}
+			if err := setValue(v.Field(i), fieldVal, fieldEnum, joinPath(path, key)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Slice:
+		if v.Type() == bytesType {
+			s, ok := jsonVal.(string)
+			if !ok {
+				return fmt.Errorf("expected a hex string for %s", path)
+			}
+			b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+			if err != nil {
+				return fmt.Errorf("invalid hex string for %s: %v", path, err)
+			}
+			v.SetBytes(b)
+			return nil
+		}
+		items, ok := jsonVal.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected an array for %s", path)
+		}
+		slice := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := setValue(slice.Index(i), item, enum, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+		return nil
+	case reflect.Int32:
+		if s, ok := jsonVal.(string); ok && enum != "" {
+			n, ok := proto.EnumValueMap(enum)[s]
+			if !ok {
+				return fmt.Errorf("unknown value %q for %s", s, path)
+			}
+			v.SetInt(int64(n))
+			return nil
+		}
+		n, err := jsonInt(jsonVal, 32)
+		if err != nil {
+			return fmt.Errorf("invalid integer for %s: %v", path, err)
+		}
+		v.SetInt(n)
+		return nil
+	case reflect.Int64:
+		n, err := jsonInt(jsonVal, 64)
+		if err != nil {
+			return fmt.Errorf("invalid integer for %s: %v", path, err)
+		}
+		v.SetInt(n)
+		return nil
+	case reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(jsonNumber(jsonVal), 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid integer for %s: %v", path, err)
+		}
+		v.SetUint(n)
+		return nil
+	case reflect.Bool, reflect.String:
+		val := reflect.ValueOf(jsonVal)
+		if val.Kind() != v.Kind() {
+			return fmt.Errorf("expected a %s for %s", v.Kind(), path)
+		}
+		v.Set(val.Convert(v.Type()))
+		return nil
+	default:
+		return fmt.Errorf("unsupported field type %s for %s", v.Type(), path)
+	}
+}
+
+// protoField returns the proto name of a message struct field and its enum name if it is
+// an enum field. Fields which are not part of the proto definition are not ok.
+func protoField(field reflect.StructField) (name string, enum string, ok bool) {
+	tag := field.Tag.Get("protobuf")
+	if tag == "" {
+		return "", "", false
+	}
+	for _, opt := range strings.Split(tag, ",") {
+		if strings.HasPrefix(opt, "name=") {
+			name = strings.TrimPrefix(opt, "name=")
+		}
+		if strings.HasPrefix(opt, "enum=") {
+			enum = strings.TrimPrefix(opt, "enum=")
+		}
+	}
+	return name, enum, name != ""
+}
+
+// structField returns the index of the message struct field with the given proto name.
+func structField(structType reflect.Type, name string) (int, string, bool) {
+	for i := 0; i < structType.NumField(); i++ {
+		fieldName, enum, ok := protoField(structType.Field(i))
+		if ok && fieldName == name {
+			return i, enum, true
+		}
+	}
+	return 0, "", false
+}
+
+// jsonNumber returns the decimal representation of an integer encoded as a JSON number or
+// as a JSON string.
+func jsonNumber(jsonVal interface{}) string {
+	switch n := jsonVal.(type) {
+	case json.Number:
+		return n.String()
+	case string:
+		return n
+	default:
+		return fmt.Sprint(jsonVal)
+	}
+}
+
+func jsonInt(jsonVal interface{}, bits int) (int64, error) {
+	return strconv.ParseInt(jsonNumber(jsonVal), 10, bits)
+}
+
+func joinPath(path string, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "the request"
+	}
+	return path
+}
@@ -0,0 +1,98 @@
+package gateway
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+func TestMarshalJSON_HexBytesAndStringIntegers(t *testing.T) {
+	msg := &pb.AttestationDataResponse{
+		BeaconBlockRootHash32: []byte{0xab, 0xcd},
+		JustifiedEpoch:        1 << 63,
+		LatestCrosslink:       &pbp2p.Crosslink{Epoch: 1},
+	}
+	enc, err := marshalJSON(msg)
+	if err != nil {
+		t.Fatalf("Could not marshal message: %v", err)
+	}
+	for _, want := range []string{
+		`"beacon_block_root_hash32":"0xabcd"`,
+		`"justified_epoch":"9223372036854775808"`,
+		`"latest_crosslink":{`,
+	} {
+		if !strings.Contains(string(enc), want) {
+			t.Errorf("Expected %s to contain %s", enc, want)
+		}
+	}
+
+	decoded := &pb.AttestationDataResponse{}
+	if err := unmarshalJSON(enc, decoded); err != nil {
+		t.Fatalf("Could not unmarshal message: %v", err)
+	}
+	if !proto.Equal(decoded, msg) {
+		t.Errorf("Expected %v, received %v", msg, decoded)
+	}
+}
+
+func TestMarshalJSON_EnumsAndTimestamps(t *testing.T) {
+	msgs := []proto.Message{
+		&pb.ListValidatorsRequest{Statuses: []pb.ValidatorStatus{pb.ValidatorStatus_ACTIVE, pb.ValidatorStatus_EXITED}},
+		&pb.ProposeRequest{Timestamp: &ptypes.Timestamp{Seconds: 1550000000, Nanos: 5}},
+	}
+	for _, msg := range msgs {
+		enc, err := marshalJSON(msg)
+		if err != nil {
+			t.Fatalf("Could not marshal message: %v", err)
+		}
+		decoded := proto.Clone(msg)
+		decoded.Reset()
+		if err := unmarshalJSON(enc, decoded); err != nil {
+			t.Fatalf("Could not unmarshal %s: %v", enc, err)
+		}
+		if !proto.Equal(decoded, msg) {
+			t.Errorf("Expected %v, received %v", msg, decoded)
+		}
+	}
+
+	enc, err := marshalJSON(msgs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `"statuses":["ACTIVE","EXITED"]`; !strings.Contains(string(enc), want) {
+		t.Errorf("Expected %s to contain %s", enc, want)
+	}
+}
+
+func TestUnmarshalJSON_AcceptsNumbers(t *testing.T) {
+	msg := &pb.AttestationDataRequest{}
+	if err := unmarshalJSON([]byte(`{"shard": 3, "slot": "9223372036854775809"}`), msg); err != nil {
+		t.Fatalf("Could not unmarshal message: %v", err)
+	}
+	want := &pb.AttestationDataRequest{Shard: 3, Slot: 1<<63 + 1}
+	if !proto.Equal(msg, want) {
+		t.Errorf("Expected %v, received %v", want, msg)
+	}
+}
+
+func TestUnmarshalJSON_Errors(t *testing.T) {
+	tests := []struct {
+		json string
+		want string
+	}{
+		{json: `{"slot_number": "1", "unknown": 1}`, want: "unknown field unknown"},
+		{json: `{"parent_hash": "0xzz"}`, want: "invalid hex string for parent_hash"},
+		{json: `{"slot_number": "-1"}`, want: "invalid integer for slot_number"},
+		{json: `[]`, want: "expected an object for the request"},
+	}
+	for _, tt := range tests {
+		err := unmarshalJSON([]byte(tt.json), &pb.ProposeRequest{})
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Expected error containing %q for %s, received %v", tt.want, tt.json, err)
+		}
+	}
+}
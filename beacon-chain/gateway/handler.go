@@ -0,0 +1,225 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxRequestSize is the largest request body accepted by the gateway.
+	maxRequestSize = 10 << 20
+	// forwardedForKey is the metadata key carrying the address of the HTTP client of a call.
+	forwardedForKey = "x-forwarded-for"
+	// gatewaySecretKey is the metadata key carrying the secret which authenticates the
+	// gateway to the RPC server, so that it trusts the forwarded client address.
+	gatewaySecretKey = "x-gateway-secret"
+)
+
+var (
+	contextType      = reflect.TypeOf((*context.Context)(nil)).Elem()
+	messageType      = reflect.TypeOf((*proto.Message)(nil)).Elem()
+	clientStreamType = reflect.TypeOf((*grpc.ClientStream)(nil)).Elem()
+	errorType        = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// registerService routes every method of a gRPC service client to /v1/<service>/<method>,
+// where clientInterface is a pointer to the client interface type generated for the
+// service, as in (*pb.BeaconServiceClient)(nil). The methods take their request message
+// as the JSON body of the HTTP request, which may be empty. Unary methods respond with
+// their response message, server-streaming methods respond with a server-sent event for
+// each message of the stream. The calls are authenticated with the gateway secret.
+func registerService(mux *http.ServeMux, service string, clientInterface interface{}, client interface{}, secret string) error {
+	ifaceType := reflect.TypeOf(clientInterface).Elem()
+	clientVal := reflect.ValueOf(client)
+	for i := 0; i < ifaceType.NumMethod(); i++ {
+		method := ifaceType.Method(i)
+		if err := checkMethod(method.Type); err != nil {
+			return fmt.Errorf("could not route %s.%s: %v", service, method.Name, err)
+		}
+		h := &methodHandler{
+			call:      clientVal.MethodByName(method.Name),
+			request:   method.Type.In(1).Elem(),
+			streaming: method.Type.Out(0).Implements(clientStreamType),
+			secret:    secret,
+		}
+		mux.Handle(fmt.Sprintf("/v1/%s/%s", service, method.Name), h)
+	}
+	return nil
+}
+
+// checkMethod checks that a client method is shaped like the unary and server-streaming
+// methods generated by the gRPC plugin.
+func checkMethod(methodType reflect.Type) error {
+	if methodType.NumIn() != 3 || !methodType.IsVariadic() || methodType.In(0) != contextType ||
+		!methodType.In(1).Implements(messageType) {
+		return fmt.Errorf("unexpected parameters %s", methodType)
+	}
+	if methodType.NumOut() != 2 || methodType.Out(1) != errorType {
+		return fmt.Errorf("unexpected results %s", methodType)
+	}
+	out := methodType.Out(0)
+	if !out.Implements(messageType) && !out.Implements(clientStreamType) {
+		return fmt.Errorf("unexpected result type %s", out)
+	}
+	return nil
+}
+
+// methodHandler serves a gRPC client method over HTTP.
+type methodHandler struct {
+	call      reflect.Value
+	request   reflect.Type
+	streaming bool
+	secret    string
+}
+
+func (h *methodHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("could not read request: %v", err))
+		return
+	}
+	req := reflect.New(h.request)
+	if err := unmarshalJSON(body, req.Interface().(proto.Message)); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	out := h.call.Call([]reflect.Value{reflect.ValueOf(forwardedContext(r, h.secret)), req})
+	if err, _ := out[1].Interface().(error); err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+	if h.streaming {
+		h.serveStream(w, r, out[0].Interface().(grpc.ClientStream))
+		return
+	}
+	res, err := marshalJSON(out[0].Interface().(proto.Message))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("could not encode response: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(res); err != nil {
+		log.Debugf("Could not write response: %v", err)
+	}
+}
+
+// forwardedContext returns the context of the gRPC call made for the HTTP request, carrying
+// the address of the HTTP client along with the gateway secret so that the RPC server rate
+// limits each client of the gateway on its own.
+func forwardedContext(r *http.Request, secret string) context.Context {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if secret == "" {
+		return metadata.AppendToOutgoingContext(r.Context(), forwardedForKey, host)
+	}
+	return metadata.AppendToOutgoingContext(r.Context(), gatewaySecretKey, secret, forwardedForKey, host)
+}
+
+// serveStream writes a server-sent event for each message received on the stream, until
+// the stream ends or the HTTP client goes away.
+func (h *methodHandler) serveStream(w http.ResponseWriter, r *http.Request, stream grpc.ClientStream) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, errors.New("streaming is not supported by the connection"))
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	recv := reflect.ValueOf(stream).MethodByName("Recv")
+	for {
+		out := recv.Call(nil)
+		if err, _ := out[1].Interface().(error); err != nil {
+			if err != io.EOF && r.Context().Err() == nil {
+				writeEvent(w, "error", jsonError(errors.New(status.Convert(err).Message())))
+			}
+			return
+		}
+		data, err := marshalJSON(out[0].Interface().(proto.Message))
+		if err != nil {
+			writeEvent(w, "error", jsonError(fmt.Errorf("could not encode message: %v", err)))
+			return
+		}
+		if !writeEvent(w, "", data) {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// writeEvent writes a server-sent event of the given type, or a message event if the type
+// is empty. The data must not contain new lines, as is the case for encoded JSON.
+func writeEvent(w http.ResponseWriter, eventType string, data []byte) bool {
+	if eventType != "" {
+		if _, err := fmt.Fprintf(w, "event: %s\n", eventType); err != nil {
+			return false
+		}
+	}
+	if _, err := fmt.Fprintf(w, "data: %s\n\n", data); err != nil {
+		log.Debugf("Could not write event: %v", err)
+		return false
+	}
+	return true
+}
+
+// writeGRPCError writes the error of a gRPC call with the HTTP status of its code.
+func writeGRPCError(w http.ResponseWriter, err error) {
+	httpStatus := http.StatusInternalServerError
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.OutOfRange, codes.FailedPrecondition:
+		httpStatus = http.StatusBadRequest
+	case codes.NotFound:
+		httpStatus = http.StatusNotFound
+	case codes.PermissionDenied:
+		httpStatus = http.StatusForbidden
+	case codes.Unauthenticated:
+		httpStatus = http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		httpStatus = http.StatusTooManyRequests
+	case codes.Unimplemented:
+		httpStatus = http.StatusNotImplemented
+	case codes.Unavailable:
+		httpStatus = http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		httpStatus = http.StatusGatewayTimeout
+	}
+	writeError(w, httpStatus, errors.New(status.Convert(err).Message()))
+}
+
+// writeError writes an error as a JSON object with an error field.
+func writeError(w http.ResponseWriter, httpStatus int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	if _, err := w.Write(jsonError(err)); err != nil {
+		log.Debugf("Could not write error: %v", err)
+	}
+}
+
+// jsonError encodes an error as a JSON object with an error field.
+func jsonError(err error) []byte {
+	// Encoding a map of strings cannot fail.
+	enc, _ := json.Marshal(map[string]string{"error": err.Error()})
+	return enc
+}
@@ -0,0 +1,181 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type mockAttesterServer struct {
+	request       *pb.AttestationDataRequest
+	forwardedFor  []string
+	gatewaySecret []string
+}
+
+func (m *mockAttesterServer) AttestHead(context.Context, *pbp2p.Attestation) (*pb.AttestResponse, error) {
+	return nil, status.Error(codes.InvalidArgument, "bad attestation")
+}
+
+func (m *mockAttesterServer) AttestationDataAtSlot(ctx context.Context, req *pb.AttestationDataRequest) (*pb.AttestationDataResponse, error) {
+	m.request = req
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		m.forwardedFor = md[forwardedForKey]
+		m.gatewaySecret = md[gatewaySecretKey]
+	}
+	return &pb.AttestationDataResponse{BeaconBlockRootHash32: []byte{1, 2}, JustifiedEpoch: req.Slot}, nil
+}
+
+// mockBeaconServer only implements the attestation stream, the other methods panic.
+type mockBeaconServer struct {
+	pb.BeaconServiceServer
+	attestations []*pbp2p.Attestation
+}
+
+func (m *mockBeaconServer) LatestAttestation(_ *ptypes.Empty, stream pb.BeaconService_LatestAttestationServer) error {
+	for _, att := range m.attestations {
+		if err := stream.Send(att); err != nil {
+			return err
+		}
+	}
+	return errors.New("stream ended")
+}
+
+// setupGateway serves the mock servers over gRPC and returns an HTTP server proxying to them.
+func setupGateway(t *testing.T, attester *mockAttesterServer, beacon *mockBeaconServer) (*httptest.Server, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcServer := grpc.NewServer()
+	pb.RegisterAttesterServiceServer(grpcServer, attester)
+	pb.RegisterBeaconServiceServer(grpcServer, beacon)
+	go grpcServer.Serve(lis)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux, err := newMux(conn, "secret")
+	if err != nil {
+		t.Fatalf("Could not route services: %v", err)
+	}
+	server := httptest.NewServer(mux)
+	return server, func() {
+		server.Close()
+		conn.Close()
+		grpcServer.Stop()
+	}
+}
+
+func TestGateway_UnaryMethod(t *testing.T) {
+	attester := &mockAttesterServer{}
+	server, teardown := setupGateway(t, attester, &mockBeaconServer{})
+	defer teardown()
+
+	res, err := http.Post(server.URL+"/v1/AttesterService/AttestationDataAtSlot", "application/json",
+		strings.NewReader(`{"shard": "2", "slot": "9223372036854775809"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, received %d: %s", res.StatusCode, body)
+	}
+	if want := (&pb.AttestationDataRequest{Shard: 2, Slot: 1<<63 + 1}); !proto.Equal(attester.request, want) {
+		t.Errorf("Expected request %v, received %v", want, attester.request)
+	}
+	if len(attester.forwardedFor) != 1 || attester.forwardedFor[0] != "127.0.0.1" {
+		t.Errorf("Expected the call to be forwarded for 127.0.0.1, received %v", attester.forwardedFor)
+	}
+	if len(attester.gatewaySecret) != 1 || attester.gatewaySecret[0] != "secret" {
+		t.Errorf("Expected the call to carry the gateway secret, received %v", attester.gatewaySecret)
+	}
+	for _, want := range []string{`"beacon_block_root_hash32":"0x0102"`, `"justified_epoch":"9223372036854775809"`} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("Expected %s to contain %s", body, want)
+		}
+	}
+}
+
+func TestGateway_Errors(t *testing.T) {
+	server, teardown := setupGateway(t, &mockAttesterServer{}, &mockBeaconServer{})
+	defer teardown()
+
+	tests := []struct {
+		path   string
+		body   string
+		status int
+	}{
+		{path: "/v1/AttesterService/AttestHead", body: `{}`, status: http.StatusBadRequest},
+		{path: "/v1/AttesterService/AttestHead", body: `{"unknown": 1}`, status: http.StatusBadRequest},
+		{path: "/v1/ValidatorService/ValidatorIndex", body: `{}`, status: http.StatusNotImplemented},
+		{path: "/v1/AttesterService/Unknown", body: `{}`, status: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		res, err := http.Post(server.URL+tt.path, "application/json", strings.NewReader(tt.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if res.StatusCode != tt.status {
+			t.Errorf("Expected status %d for %s %s, received %d", tt.status, tt.path, tt.body, res.StatusCode)
+		}
+	}
+}
+
+func TestGateway_StreamingMethod(t *testing.T) {
+	beacon := &mockBeaconServer{
+		attestations: []*pbp2p.Attestation{
+			{AggregationBitfield: []byte{1}},
+			{AggregationBitfield: []byte{2}},
+		},
+	}
+	server, teardown := setupGateway(t, &mockAttesterServer{}, beacon)
+	defer teardown()
+
+	res, err := http.Get(server.URL + "/v1/BeaconService/LatestAttestation")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if contentType := res.Header.Get("Content-Type"); contentType != "text/event-stream" {
+		t.Errorf("Expected an event stream, received %s", contentType)
+	}
+	var lines []string
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		if scanner.Text() != "" {
+			lines = append(lines, scanner.Text())
+		}
+	}
+	if len(lines) != 4 {
+		t.Fatalf("Expected 2 attestation events and an error event, received %v", lines)
+	}
+	for i, want := range []string{`"aggregation_bitfield":"0x01"`, `"aggregation_bitfield":"0x02"`} {
+		if !strings.HasPrefix(lines[i], "data: ") || !strings.Contains(lines[i], want) {
+			t.Errorf("Expected event data containing %s, received %s", want, lines[i])
+		}
+	}
+	if lines[2] != "event: error" || lines[3] != `data: {"error":"stream ended"}` {
+		t.Errorf("Expected an error event, received %v", lines[2:])
+	}
+}
@@ -0,0 +1,190 @@
+// Package gateway serves the gRPC API of the beacon node as HTTP endpoints taking and
+// returning JSON, for the consumers which cannot use gRPC.
+package gateway
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/tlsutil"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var log = logrus.WithField("prefix", "gateway")
+
+// Service proxies HTTP/JSON requests to the gRPC server of the beacon node.
+type Service struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	host         string
+	port         int
+	rpcEndpoint  string
+	withCert     string
+	withKey      string
+	withClientCA string
+	clientCert   string
+	clientKey    string
+	secret       string
+	conn         *grpc.ClientConn
+	server       *http.Server
+	failStatus   error
+}
+
+// Config options for the HTTP/JSON gateway. The gateway calls the gRPC server with the client
+// certificate of the node, so when a client CA is set it serves HTTPS with the certificate and
+// key of the node, and requires client certificates issued by the client CA in turn.
+type Config struct {
+	Host           string
+	Port           int
+	RPCEndpoint    string
+	CertFlag       string
+	KeyFlag        string
+	ClientCAFlag   string
+	ClientCertFlag string
+	ClientKeyFlag  string
+	// GatewaySecret authenticates the gateway to the gRPC server, which then rate limits
+	// each HTTP client of the gateway on its own.
+	GatewaySecret string
+}
+
+// NewGatewayService creates a gateway listening on the port of the config, proxying to
+// the gRPC server at the RPC endpoint of the config.
+func NewGatewayService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:          ctx,
+		cancel:       cancel,
+		host:         cfg.Host,
+		port:         cfg.Port,
+		rpcEndpoint:  cfg.RPCEndpoint,
+		withCert:     cfg.CertFlag,
+		withKey:      cfg.KeyFlag,
+		withClientCA: cfg.ClientCAFlag,
+		clientCert:   cfg.ClientCertFlag,
+		clientKey:    cfg.ClientKeyFlag,
+		secret:       cfg.GatewaySecret,
+	}
+}
+
+// Start dials the gRPC server and serves the HTTP endpoints.
+func (s *Service) Start() {
+	log.WithFields(logrus.Fields{
+		"host": s.host,
+		"port": s.port,
+	}).Info("Starting service")
+	dialOpt := grpc.WithInsecure()
+	if s.withCert != "" {
+		// The client certificate is only required by RPC servers which verify their clients.
+		reloader, err := tlsutil.NewReloader(s.clientCert, s.clientKey, s.withCert)
+		if err != nil {
+			log.Errorf("Could not get valid credentials: %v", err)
+			s.failStatus = err
+			return
+		}
+		go reloader.Watch(s.ctx)
+		dialOpt = grpc.WithTransportCredentials(credentials.NewTLS(reloader.ClientConfig(s.rpcEndpoint)))
+	}
+	conn, err := grpc.DialContext(s.ctx, s.rpcEndpoint, dialOpt)
+	if err != nil {
+		log.Errorf("Could not dial endpoint %s: %v", s.rpcEndpoint, err)
+		s.failStatus = err
+		return
+	}
+	s.conn = conn
+
+	mux, err := newMux(conn, s.secret)
+	if err != nil {
+		log.Errorf("Could not route gRPC services: %v", err)
+		s.failStatus = err
+		return
+	}
+	s.server = &http.Server{Addr: net.JoinHostPort(s.host, strconv.Itoa(s.port)), Handler: mux}
+
+	lis, err := net.Listen("tcp", s.server.Addr)
+	if err != nil {
+		log.Errorf("Could not listen to %s: %v", s.server.Addr, err)
+		s.failStatus = err
+		return
+	}
+	// Clients of the gateway get the access of the node client certificate, so they must be
+	// authorized by the client CA as much as the clients of the gRPC server.
+	if s.withClientCA != "" {
+		reloader, err := s.serverCredentials()
+		if err != nil {
+			log.Errorf("Could not load TLS keys: %v", err)
+			s.failStatus = err
+			// #nosec G104
+			lis.Close()
+			return
+		}
+		go reloader.Watch(s.ctx)
+		lis = tls.NewListener(lis, reloader.ServerConfig())
+	}
+	go func() {
+		if err := s.server.Serve(lis); err != nil && err != http.ErrServerClosed {
+			log.Errorf("Could not serve HTTP: %v", err)
+			s.failStatus = err
+		}
+	}()
+}
+
+// serverCredentials loads the certificate and key of the node along with the client CA,
+// which the clients of the gateway are verified against.
+func (s *Service) serverCredentials() (*tlsutil.Reloader, error) {
+	if s.withCert == "" || s.withKey == "" {
+		return nil, errors.New("a client CA requires the certificate and key of the node")
+	}
+	return tlsutil.NewReloader(s.withCert, s.withKey, s.withClientCA)
+}
+
+// Stop the HTTP server and close the connection to the gRPC server.
+func (s *Service) Stop() error {
+	log.Info("Stopping service")
+	defer s.cancel()
+	if s.server != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		if err := s.server.Shutdown(ctx); err != nil {
+			return err
+		}
+	}
+	if s.conn != nil {
+		return s.conn.Close()
+	}
+	return nil
+}
+
+// Status returns the error which stopped the gateway from serving, if any.
+func (s *Service) Status() error {
+	return s.failStatus
+}
+
+// newMux routes the gRPC services of the beacon node served by the gateway, whose calls are
+// authenticated with the given gateway secret.
+func newMux(conn *grpc.ClientConn, secret string) (*http.ServeMux, error) {
+	mux := http.NewServeMux()
+	services := []struct {
+		name            string
+		clientInterface interface{}
+		client          interface{}
+	}{
+		{"BeaconService", (*pb.BeaconServiceClient)(nil), pb.NewBeaconServiceClient(conn)},
+		{"ValidatorService", (*pb.ValidatorServiceClient)(nil), pb.NewValidatorServiceClient(conn)},
+		{"ProposerService", (*pb.ProposerServiceClient)(nil), pb.NewProposerServiceClient(conn)},
+		{"AttesterService", (*pb.AttesterServiceClient)(nil), pb.NewAttesterServiceClient(conn)},
+	}
+	for _, service := range services {
+		if err := registerService(mux, service.name, service.clientInterface, service.client, secret); err != nil {
+			return nil, err
+		}
+	}
+	return mux, nil
+}
@@ -0,0 +1,45 @@
+package gateway
+
+import (
+	"context"
+	"net"
+	"testing"
+)
+
+func TestStart_ListensOnHost(t *testing.T) {
+	service := NewGatewayService(context.Background(), &Config{
+		Host:        "127.0.0.1",
+		Port:        7380,
+		RPCEndpoint: "localhost:7381",
+	})
+	service.Start()
+	defer service.Stop()
+
+	if err := service.Status(); err != nil {
+		t.Fatalf("Could not start gateway: %v", err)
+	}
+	conn, err := net.Dial("tcp", "127.0.0.1:7380")
+	if err != nil {
+		t.Fatalf("Could not connect to gateway: %v", err)
+	}
+	conn.Close()
+}
+
+func TestStart_ClientCARequiresNodeCertificate(t *testing.T) {
+	service := NewGatewayService(context.Background(), &Config{
+		Host:         "127.0.0.1",
+		Port:         7382,
+		RPCEndpoint:  "localhost:7383",
+		ClientCAFlag: "ca.crt",
+	})
+	service.Start()
+	defer service.Stop()
+
+	if service.Status() == nil {
+		t.Error("Expected the gateway not to serve plaintext HTTP when a client CA is set")
+	}
+	if conn, err := net.Dial("tcp", "127.0.0.1:7382"); err == nil {
+		conn.Close()
+		t.Error("Expected the gateway not to listen")
+	}
+}
@@ -16,13 +16,6 @@ import (
 )
 
 func startNode(ctx *cli.Context) error {
-	verbosity := ctx.GlobalString(cmd.VerbosityFlag.Name)
-	level, err := logrus.ParseLevel(verbosity)
-	if err != nil {
-		return err
-	}
-	logrus.SetLevel(level)
-
 	beacon, err := node.NewBeaconNode(ctx)
 	if err != nil {
 		return err
@@ -42,6 +35,11 @@ func main() {
 	app.Usage = "this is a beacon chain implementation for Ethereum 2.0"
 	app.Action = startNode
 	app.Version = version.GetVersion()
+	app.Commands = []cli.Command{
+		exportChainCommand,
+		importChainCommand,
+		dbCommand,
+	}
 
 	app.Flags = []cli.Flag{
 		utils.DemoConfigFlag,
@@ -50,8 +48,20 @@ func main() {
 		utils.RPCPort,
 		utils.CertFlag,
 		utils.KeyFlag,
+		utils.ClientCAFlag,
+		utils.ClientCertFlag,
+		utils.ClientKeyFlag,
+		utils.GRPCGatewayPort,
+		utils.GRPCGatewayHost,
+		utils.RPCRateLimitFlag,
+		utils.RPCRateBurstFlag,
+		utils.RPCMethodConcurrencyFlag,
+		utils.RPCMaxMsgSizeFlag,
+		utils.EnableAdminRPCFlag,
 		utils.GenesisJSON,
 		utils.EnableDBCleanup,
+		utils.StateStorageFlag,
+		utils.PerformanceRetentionFlag,
 		utils.ChainStartDelay,
 		cmd.BootstrapNode,
 		cmd.RelayNode,
@@ -73,6 +83,11 @@ func main() {
 
 	app.Before = func(ctx *cli.Context) error {
 		runtime.GOMAXPROCS(runtime.NumCPU())
+		level, err := logrus.ParseLevel(ctx.GlobalString(cmd.VerbosityFlag.Name))
+		if err != nil {
+			return err
+		}
+		logrus.SetLevel(level)
 		return debug.Setup(ctx)
 	}
 
@@ -17,6 +17,8 @@ import (
 	gethRPC "github.com/ethereum/go-ethereum/rpc"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/dbcleanup"
+	"github.com/prysmaticlabs/prysm/beacon-chain/gateway"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
@@ -99,6 +101,12 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if ctx.GlobalBool(utils.EnableDBCleanup.Name) {
+		if err := beacon.registerDBCleanService(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := beacon.registerSyncService(ctx); err != nil {
 		return nil, err
 	}
@@ -107,6 +115,12 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if ctx.GlobalInt(utils.GRPCGatewayPort.Name) != 0 {
+		if err := beacon.registerGatewayService(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	if !ctx.GlobalBool(cmd.DisableMonitoringFlag.Name) {
 		if err := beacon.registerPrometheusService(ctx); err != nil {
 			return nil, err
@@ -164,18 +178,46 @@ func (b *BeaconNode) Close() {
 }
 
 func (b *BeaconNode) startDB(ctx *cli.Context) error {
-	baseDir := ctx.GlobalString(cmd.DataDirFlag.Name)
-
-	db, err := db.NewDB(path.Join(baseDir, beaconChainDBName))
+	beaconDB, err := OpenDB(ctx)
 	if err != nil {
 		return err
 	}
 
 	log.Info("checking db")
-	b.db = db
+	b.db = beaconDB
 	return nil
 }
 
+// DBPath returns the directory of the beacon chain DB in the data directory given on the
+// command line.
+func DBPath(ctx *cli.Context) string {
+	return path.Join(ctx.GlobalString(cmd.DataDirFlag.Name), beaconChainDBName)
+}
+
+// OpenDB opens the beacon chain DB in the data directory given on the command line,
+// configured with the state storage policy and the performance retention of the command
+// line flags.
+func OpenDB(ctx *cli.Context) (*db.BeaconDB, error) {
+	beaconDB, err := db.NewDB(DBPath(ctx))
+	if err != nil {
+		return nil, err
+	}
+	switch policy := ctx.GlobalString(utils.StateStorageFlag.Name); policy {
+	case "", "epoch-boundary":
+		beaconDB.SetStateStoragePolicy(db.StoreEpochBoundaryStates)
+	case "all":
+		beaconDB.SetStateStoragePolicy(db.StoreAllStates)
+	default:
+		// #nosec G104
+		beaconDB.Close()
+		return nil, fmt.Errorf("unknown state storage policy %q", policy)
+	}
+	if ctx.GlobalIsSet(utils.PerformanceRetentionFlag.Name) {
+		beaconDB.SetPerformanceRetention(ctx.GlobalUint64(utils.PerformanceRetentionFlag.Name))
+	}
+	return beaconDB, nil
+}
+
 func (b *BeaconNode) registerP2P(ctx *cli.Context) error {
 	beaconp2p, err := configureP2P(ctx)
 	if err != nil {
@@ -206,6 +248,7 @@ func (b *BeaconNode) registerBlockchainService(_ *cli.Context) error {
 		AttsService:      attsService,
 		BeaconBlockBuf:   10,
 		IncomingBlockBuf: 100, // Big buffer to accommodate other feed subscribers.
+		MaxPendingBlocks: 1024,
 	})
 	if err != nil {
 		return fmt.Errorf("could not register blockchain service: %v", err)
@@ -213,6 +256,20 @@ func (b *BeaconNode) registerBlockchainService(_ *cli.Context) error {
 	return b.services.RegisterService(blockchainService)
 }
 
+func (b *BeaconNode) registerDBCleanService(_ *cli.Context) error {
+	var chainService *blockchain.ChainService
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	dbCleanService := dbcleanup.NewCleanupService(context.Background(), &dbcleanup.Config{
+		SubscriptionBuf: 100,
+		BeaconDB:        b.db,
+		ChainService:    chainService,
+	})
+	return b.services.RegisterService(dbCleanService)
+}
+
 func (b *BeaconNode) registerOperationService() error {
 	operationService := operations.NewOpsPoolService(context.Background(), &operations.Config{
 		BeaconDB: b.db,
@@ -317,25 +374,69 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 		return err
 	}
 
+	var p2pService *p2p.Server
+	if err := b.services.FetchService(&p2pService); err != nil {
+		return err
+	}
+
+	var syncService *rbcsync.Service
+	if err := b.services.FetchService(&syncService); err != nil {
+		return err
+	}
+
 	port := ctx.GlobalString(utils.RPCPort.Name)
 	cert := ctx.GlobalString(utils.CertFlag.Name)
 	key := ctx.GlobalString(utils.KeyFlag.Name)
+	clientCA := ctx.GlobalString(utils.ClientCAFlag.Name)
 	chainStartDelayFlag := ctx.GlobalUint64(utils.ChainStartDelay.Name)
+	methodConcurrency, err := rpc.ParseMethodConcurrency(ctx.GlobalStringSlice(utils.RPCMethodConcurrencyFlag.Name))
+	if err != nil {
+		return fmt.Errorf("could not parse RPC concurrency caps: %v", err)
+	}
 	rpcService := rpc.NewRPCService(context.Background(), &rpc.Config{
 		Port:                port,
 		CertFlag:            cert,
 		KeyFlag:             key,
+		ClientCAFlag:        clientCA,
 		ChainStartDelayFlag: chainStartDelayFlag,
 		SubscriptionBuf:     100,
 		BeaconDB:            b.db,
 		ChainService:        chainService,
 		OperationService:    operationService,
 		POWChainService:     web3Service,
+		P2P:                 p2pService,
+		SyncService:         syncService,
+		ServiceRegistry:     b.services,
+		RateLimit:           ctx.GlobalFloat64(utils.RPCRateLimitFlag.Name),
+		RateBurst:           ctx.GlobalInt(utils.RPCRateBurstFlag.Name),
+		MethodConcurrency:   methodConcurrency,
+		MaxMsgSize:          ctx.GlobalInt(utils.RPCMaxMsgSizeFlag.Name),
+		EnableAdminRPC:      ctx.GlobalBool(utils.EnableAdminRPCFlag.Name),
 	})
 
 	return b.services.RegisterService(rpcService)
 }
 
+func (b *BeaconNode) registerGatewayService(ctx *cli.Context) error {
+	var rpcService *rpc.Service
+	if err := b.services.FetchService(&rpcService); err != nil {
+		return err
+	}
+
+	gatewayService := gateway.NewGatewayService(context.Background(), &gateway.Config{
+		Host:           ctx.GlobalString(utils.GRPCGatewayHost.Name),
+		Port:           ctx.GlobalInt(utils.GRPCGatewayPort.Name),
+		RPCEndpoint:    fmt.Sprintf("localhost:%s", ctx.GlobalString(utils.RPCPort.Name)),
+		CertFlag:       ctx.GlobalString(utils.CertFlag.Name),
+		KeyFlag:        ctx.GlobalString(utils.KeyFlag.Name),
+		ClientCAFlag:   ctx.GlobalString(utils.ClientCAFlag.Name),
+		ClientCertFlag: ctx.GlobalString(utils.ClientCertFlag.Name),
+		ClientKeyFlag:  ctx.GlobalString(utils.ClientKeyFlag.Name),
+		GatewaySecret:  rpcService.GatewaySecret(),
+	})
+	return b.services.RegisterService(gatewayService)
+}
+
 func (b *BeaconNode) registerPrometheusService(ctx *cli.Context) error {
 	service := prometheus.NewPrometheusService(
 		fmt.Sprintf(":%d", ctx.GlobalInt64(cmd.MonitoringPortFlag.Name)),
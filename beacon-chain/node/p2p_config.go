@@ -22,6 +22,9 @@ var topicMappings = map[pb.Topic]proto.Message{
 	pb.Topic_BEACON_STATE_HASH_ANNOUNCE:          &pb.BeaconStateHashAnnounce{},
 	pb.Topic_BEACON_STATE_REQUEST:                &pb.BeaconStateRequest{},
 	pb.Topic_BEACON_STATE_RESPONSE:               &pb.BeaconStateResponse{},
+	pb.Topic_PROPOSER_SLASHING:                   &pb.ProposerSlashing{},
+	pb.Topic_ATTESTER_SLASHING:                   &pb.AttesterSlashing{},
+	pb.Topic_VOLUNTARY_EXIT:                      &pb.VoluntaryExit{},
 }
 
 func configureP2P(ctx *cli.Context) (*p2p.Server, error) {
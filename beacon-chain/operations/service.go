@@ -4,7 +4,7 @@ package operations
 import (
 	"context"
 	"fmt"
-	"sort"
+	"math"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -26,6 +26,10 @@ type Service struct {
 	incomingValidatorExits     chan *pb.VoluntaryExit
 	incomingAttFeed            *event.Feed
 	incomingAtt                chan *pb.Attestation
+	incomingProposerSlashings  chan *pb.ProposerSlashing
+	incomingAttesterSlashings  chan *pb.AttesterSlashing
+	proposerSlashingFeed       *event.Feed
+	attesterSlashingFeed       *event.Feed
 	incomingProcessedBlockFeed *event.Feed
 	incomingProcessedBlock     chan *pb.BeaconBlock
 	error                      error
@@ -33,10 +37,11 @@ type Service struct {
 
 // Config options for the service.
 type Config struct {
-	BeaconDB        *db.BeaconDB
-	ReceiveExitBuf  int
-	ReceiveAttBuf   int
-	ReceiveBlockBuf int
+	BeaconDB           *db.BeaconDB
+	ReceiveExitBuf     int
+	ReceiveAttBuf      int
+	ReceiveSlashingBuf int
+	ReceiveBlockBuf    int
 }
 
 // NewOpsPoolService instantiates a new service instance that will
@@ -51,6 +56,10 @@ func NewOpsPoolService(ctx context.Context, cfg *Config) *Service {
 		incomingValidatorExits:     make(chan *pb.VoluntaryExit, cfg.ReceiveExitBuf),
 		incomingAttFeed:            new(event.Feed),
 		incomingAtt:                make(chan *pb.Attestation, cfg.ReceiveAttBuf),
+		proposerSlashingFeed:       new(event.Feed),
+		incomingProposerSlashings:  make(chan *pb.ProposerSlashing, cfg.ReceiveSlashingBuf),
+		attesterSlashingFeed:       new(event.Feed),
+		incomingAttesterSlashings:  make(chan *pb.AttesterSlashing, cfg.ReceiveSlashingBuf),
 		incomingProcessedBlockFeed: new(event.Feed),
 		incomingProcessedBlock:     make(chan *pb.BeaconBlock, cfg.ReceiveBlockBuf),
 	}
@@ -91,6 +100,18 @@ func (s *Service) IncomingAttFeed() *event.Feed {
 	return s.incomingAttFeed
 }
 
+// IncomingProposerSlashingFeed returns a feed that any service can send incoming proposer slashings into.
+// The beacon block operation pool service will subscribe to this feed in order to relay incoming slashings.
+func (s *Service) IncomingProposerSlashingFeed() *event.Feed {
+	return s.proposerSlashingFeed
+}
+
+// IncomingAttesterSlashingFeed returns a feed that any service can send incoming attester slashings into.
+// The beacon block operation pool service will subscribe to this feed in order to relay incoming slashings.
+func (s *Service) IncomingAttesterSlashingFeed() *event.Feed {
+	return s.attesterSlashingFeed
+}
+
 // IncomingProcessedBlockFeed returns a feed that any service can send incoming p2p beacon blocks into.
 // The beacon block operation pool service will subscribe to this feed in order to receive incoming beacon blocks.
 func (s *Service) IncomingProcessedBlockFeed() *event.Feed {
@@ -101,24 +122,43 @@ func (s *Service) IncomingProcessedBlockFeed() *event.Feed {
 // returns in slot ascending order and up to MaxAttestations capacity. The attestations get
 // deleted in DB after they have been retrieved.
 func (s *Service) PendingAttestations() ([]*pb.Attestation, error) {
-	var attestations []*pb.Attestation
-	attestationsFromDB, err := s.beaconDB.Attestations()
+	attestations, err := s.beaconDB.AttestationsBySlotRange(0, math.MaxUint64, int(params.BeaconConfig().MaxAttestations))
 	if err != nil {
-		return nil, fmt.Errorf("could not retrieve attestations from DB")
-	}
-	sort.Slice(attestationsFromDB, func(i, j int) bool {
-		return attestationsFromDB[i].Data.Slot < attestationsFromDB[j].Data.Slot
-	})
-	for i := range attestationsFromDB {
-		// Stop the max attestation number per beacon block is reached.
-		if uint64(i) == params.BeaconConfig().MaxAttestations {
-			break
-		}
-		attestations = append(attestations, attestationsFromDB[i])
+		return nil, fmt.Errorf("could not retrieve attestations from DB: %v", err)
 	}
 	return attestations, nil
 }
 
+// PendingExits returns the exit requests that have not been seen on the beacon chain yet. They
+// are not checked against the state, as an exit may only become valid at a later epoch.
+func (s *Service) PendingExits() ([]*pb.VoluntaryExit, error) {
+	exits, err := s.beaconDB.Exits()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exits from DB: %v", err)
+	}
+	return exits, nil
+}
+
+// PendingProposerSlashings returns the proposer slashings that have not been seen on the beacon
+// chain yet. They are not checked against the state.
+func (s *Service) PendingProposerSlashings() ([]*pb.ProposerSlashing, error) {
+	slashings, err := s.beaconDB.ProposerSlashings()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve proposer slashings from DB: %v", err)
+	}
+	return slashings, nil
+}
+
+// PendingAttesterSlashings returns the attester slashings that have not been seen on the beacon
+// chain yet. They are not checked against the state.
+func (s *Service) PendingAttesterSlashings() ([]*pb.AttesterSlashing, error) {
+	slashings, err := s.beaconDB.AttesterSlashings()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve attester slashings from DB: %v", err)
+	}
+	return slashings, nil
+}
+
 // saveOperations saves the newly broadcasted beacon block operations
 // that was received from sync service.
 func (s *Service) saveOperations() {
@@ -127,6 +167,10 @@ func (s *Service) saveOperations() {
 	defer incomingSub.Unsubscribe()
 	incomingAttSub := s.incomingAttFeed.Subscribe(s.incomingAtt)
 	defer incomingAttSub.Unsubscribe()
+	proposerSlashingSub := s.proposerSlashingFeed.Subscribe(s.incomingProposerSlashings)
+	defer proposerSlashingSub.Unsubscribe()
+	attesterSlashingSub := s.attesterSlashingFeed.Subscribe(s.incomingAttesterSlashings)
+	defer attesterSlashingSub.Unsubscribe()
 
 	for {
 		select {
@@ -159,6 +203,28 @@ func (s *Service) saveOperations() {
 				continue
 			}
 			log.Infof("Attestation %#x saved in DB", hash)
+		case slashing := <-s.incomingProposerSlashings:
+			hash, err := hashutil.HashProto(slashing)
+			if err != nil {
+				log.Errorf("Could not hash proposer slashing proto: %v", err)
+				continue
+			}
+			if err := s.beaconDB.SaveProposerSlashing(slashing); err != nil {
+				log.Errorf("Could not save proposer slashing: %v", err)
+				continue
+			}
+			log.Infof("Proposer slashing %#x saved in DB", hash)
+		case slashing := <-s.incomingAttesterSlashings:
+			hash, err := hashutil.HashProto(slashing)
+			if err != nil {
+				log.Errorf("Could not hash attester slashing proto: %v", err)
+				continue
+			}
+			if err := s.beaconDB.SaveAttesterSlashing(slashing); err != nil {
+				log.Errorf("Could not save attester slashing: %v", err)
+				continue
+			}
+			log.Infof("Attester slashing %#x saved in DB", hash)
 		}
 	}
 }
@@ -183,6 +249,16 @@ func (s *Service) removeOperations() {
 				log.Errorf("Could not remove processed attestations from DB: %v", err)
 				return
 			}
+			// Removes the pending exits included in the processed block body in DB.
+			if err := s.removePendingExits(block.Body.VoluntaryExits); err != nil {
+				log.Errorf("Could not remove processed exits from DB: %v", err)
+				return
+			}
+			// Removes the pending slashings included in the processed block body in DB.
+			if err := s.removePendingSlashings(block.Body.ProposerSlashings, block.Body.AttesterSlashings); err != nil {
+				log.Errorf("Could not remove processed slashings from DB: %v", err)
+				return
+			}
 		}
 	}
 }
@@ -201,3 +277,43 @@ func (s *Service) removePendingAttestations(attestations []*pb.Attestation) erro
 	}
 	return nil
 }
+
+// removePendingExits removes a list of exit requests from DB.
+func (s *Service) removePendingExits(exits []*pb.VoluntaryExit) error {
+	for _, exit := range exits {
+		if err := s.beaconDB.DeleteExit(exit); err != nil {
+			return err
+		}
+		h, err := hashutil.HashProto(exit)
+		if err != nil {
+			return err
+		}
+		log.WithField("exitRoot", fmt.Sprintf("0x%x", h)).Info("Exit removed")
+	}
+	return nil
+}
+
+// removePendingSlashings removes a list of proposer slashings and attester slashings from DB.
+func (s *Service) removePendingSlashings(proposerSlashings []*pb.ProposerSlashing, attesterSlashings []*pb.AttesterSlashing) error {
+	for _, slashing := range proposerSlashings {
+		if err := s.beaconDB.DeleteProposerSlashing(slashing); err != nil {
+			return err
+		}
+		h, err := hashutil.HashProto(slashing)
+		if err != nil {
+			return err
+		}
+		log.WithField("slashingRoot", fmt.Sprintf("0x%x", h)).Info("Proposer slashing removed")
+	}
+	for _, slashing := range attesterSlashings {
+		if err := s.beaconDB.DeleteAttesterSlashing(slashing); err != nil {
+			return err
+		}
+		h, err := hashutil.HashProto(slashing)
+		if err != nil {
+			return err
+		}
+		log.WithField("slashingRoot", fmt.Sprintf("0x%x", h)).Info("Attester slashing removed")
+	}
+	return nil
+}
@@ -97,6 +97,42 @@ func TestIncomingExits_Ok(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, want)
 }
 
+func TestIncomingSlashings_Ok(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	exitRoutine := make(chan bool)
+	go func() {
+		service.saveOperations()
+		<-exitRoutine
+	}()
+	proposerSlashing := &pb.ProposerSlashing{ProposerIndex: 1}
+	proposerHash, err := hashutil.HashProto(proposerSlashing)
+	if err != nil {
+		t.Fatalf("Could not hash proposer slashing proto: %v", err)
+	}
+	attesterSlashing := &pb.AttesterSlashing{
+		SlashableAttestation_1: &pb.SlashableAttestation{ValidatorIndices: []uint64{1}},
+	}
+	attesterHash, err := hashutil.HashProto(attesterSlashing)
+	if err != nil {
+		t.Fatalf("Could not hash attester slashing proto: %v", err)
+	}
+
+	service.incomingProposerSlashings <- proposerSlashing
+	service.incomingAttesterSlashings <- attesterSlashing
+	service.cancel()
+	exitRoutine <- true
+
+	testutil.AssertLogsContain(t, hook, fmt.Sprintf("Proposer slashing %#x saved in DB", proposerHash))
+	testutil.AssertLogsContain(t, hook, fmt.Sprintf("Attester slashing %#x saved in DB", attesterHash))
+	if !beaconDB.HasProposerSlashing(proposerHash) || !beaconDB.HasAttesterSlashing(attesterHash) {
+		t.Error("Expected the slashings to be saved in DB")
+	}
+}
+
 func TestIncomingAttestation_OK(t *testing.T) {
 	hook := logTest.NewGlobal()
 	beaconDB := internal.SetupDB(t)
@@ -217,9 +253,28 @@ func TestReceiveBlkRemoveOps_Ok(t *testing.T) {
 			len(attestations), len(atts))
 	}
 
+	proposerSlashing := &pb.ProposerSlashing{ProposerIndex: 1}
+	if err := s.beaconDB.SaveProposerSlashing(proposerSlashing); err != nil {
+		t.Fatalf("Failed to save proposer slashing: %v", err)
+	}
+	attesterSlashing := &pb.AttesterSlashing{
+		SlashableAttestation_1: &pb.SlashableAttestation{ValidatorIndices: []uint64{1}},
+	}
+	if err := s.beaconDB.SaveAttesterSlashing(attesterSlashing); err != nil {
+		t.Fatalf("Failed to save attester slashing: %v", err)
+	}
+
+	exit := &pb.VoluntaryExit{ValidatorIndex: 1}
+	if err := s.beaconDB.SaveExit(exit); err != nil {
+		t.Fatalf("Failed to save exit: %v", err)
+	}
+
 	block := &pb.BeaconBlock{
 		Body: &pb.BeaconBlockBody{
-			Attestations: attestations,
+			Attestations:      attestations,
+			ProposerSlashings: []*pb.ProposerSlashing{proposerSlashing},
+			AttesterSlashings: []*pb.AttesterSlashing{attesterSlashing},
+			VoluntaryExits:    []*pb.VoluntaryExit{exit},
 		},
 	}
 
@@ -237,4 +292,14 @@ func TestReceiveBlkRemoveOps_Ok(t *testing.T) {
 	if len(atts) != 0 {
 		t.Errorf("Attestation pool should be empty but got a length of %d", len(atts))
 	}
+	proposerSlashings, _ := s.PendingProposerSlashings()
+	attesterSlashings, _ := s.PendingAttesterSlashings()
+	if len(proposerSlashings) != 0 || len(attesterSlashings) != 0 {
+		t.Errorf("Slashing pool should be empty but got %d proposer and %d attester slashings",
+			len(proposerSlashings), len(attesterSlashings))
+	}
+	exits, _ := s.PendingExits()
+	if len(exits) != 0 {
+		t.Errorf("Exit pool should be empty but got a length of %d", len(exits))
+	}
 }
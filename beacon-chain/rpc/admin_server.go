@@ -0,0 +1,67 @@
+package rpc
+
+import (
+	"bufio"
+	"fmt"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// backupChunkSize is the maximum number of DB bytes sent in a backup chunk.
+const backupChunkSize = 1 << 20
+
+// AdminServer defines a server implementation of the gRPC Admin service,
+// providing node operators with maintenance operations on a running node.
+type AdminServer struct {
+	beaconDB *db.BeaconDB
+}
+
+// Backup streams a consistent copy of the DB of the running node. The copy can be restored
+// into the data directory of a new node. The last chunk carries the metadata of the chain
+// held by the copy.
+func (as *AdminServer) Backup(_ *ptypes.Empty, stream pb.AdminService_BackupServer) error {
+	w := bufio.NewWriterSize(&backupStreamWriter{stream: stream}, backupChunkSize)
+	metadata, err := as.beaconDB.Backup(w)
+	if err != nil {
+		return fmt.Errorf("could not back up DB: %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("could not send backup: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"headSlot":       metadata.HeadSlot,
+		"finalizedEpoch": metadata.FinalizedEpoch,
+		"size":           metadata.Size,
+	}).Info("Sent DB backup")
+	return stream.Send(&pb.BackupChunk{
+		Metadata: &pb.BackupMetadata{
+			HeadSlot:       metadata.HeadSlot,
+			FinalizedEpoch: metadata.FinalizedEpoch,
+			SchemaVersion:  metadata.SchemaVersion,
+			SizeBytes:      metadata.Size,
+		},
+	})
+}
+
+// backupStreamWriter sends the bytes written to it as backup chunks.
+type backupStreamWriter struct {
+	stream pb.AdminService_BackupServer
+}
+
+func (w *backupStreamWriter) Write(p []byte) (int, error) {
+	for sent := 0; sent < len(p); {
+		n := len(p) - sent
+		if n > backupChunkSize {
+			n = backupChunkSize
+		}
+		// The chunk is serialized by Send, so p can be reused once it returns.
+		if err := w.stream.Send(&pb.BackupChunk{Data: p[sent : sent+n]}); err != nil {
+			return sent, err
+		}
+		sent += n
+	}
+	return len(p), nil
+}
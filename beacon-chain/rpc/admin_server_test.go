@@ -0,0 +1,114 @@
+package rpc
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestBackup_StreamsRestorableDB(t *testing.T) {
+	dir := path.Join(testutil.TempDir(), "admin-backup")
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+	beaconDB, err := db.NewDB(path.Join(dir, "node"))
+	if err != nil {
+		t.Fatalf("Could not setup DB: %v", err)
+	}
+	defer internal.TeardownDB(t, beaconDB)
+	defer os.RemoveAll(dir)
+
+	beaconState := &pbp2p.BeaconState{
+		Slot:           params.BeaconConfig().GenesisSlot + 3,
+		FinalizedEpoch: params.BeaconConfig().GenesisEpoch + 1,
+	}
+	block := blocks.NewGenesisBlock([]byte("stateroot"))
+	block.Slot = params.BeaconConfig().GenesisSlot + 3
+	if err := beaconDB.SaveBlock(block); err != nil {
+		t.Fatalf("Could not save block in test db: %v", err)
+	}
+	if err := beaconDB.UpdateChainHead(block, beaconState); err != nil {
+		t.Fatalf("Could not update chain head in test db: %v", err)
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := internal.NewMockAdminService_BackupServer(ctrl)
+	var data bytes.Buffer
+	var metadata *pb.BackupMetadata
+	mockStream.EXPECT().Send(gomock.Any()).Do(func(chunk *pb.BackupChunk) {
+		if metadata != nil {
+			t.Error("Received a chunk after the backup metadata")
+		}
+		data.Write(chunk.Data)
+		metadata = chunk.Metadata
+	}).Return(nil).AnyTimes()
+
+	adminServer := &AdminServer{beaconDB: beaconDB}
+	if err := adminServer.Backup(&ptypes.Empty{}, mockStream); err != nil {
+		t.Fatalf("Could not back up DB: %v", err)
+	}
+	if metadata == nil {
+		t.Fatal("Expected the last chunk to carry the backup metadata")
+	}
+	if metadata.HeadSlot != block.Slot {
+		t.Errorf("Expected head slot %d, received %d", block.Slot, metadata.HeadSlot)
+	}
+	if metadata.FinalizedEpoch != beaconState.FinalizedEpoch {
+		t.Errorf("Expected finalized epoch %d, received %d", beaconState.FinalizedEpoch, metadata.FinalizedEpoch)
+	}
+	if metadata.SizeBytes != uint64(data.Len()) {
+		t.Errorf("Expected %d bytes, received %d", metadata.SizeBytes, data.Len())
+	}
+
+	restoreDir := path.Join(dir, "restored")
+	if err := db.RestoreBackup(restoreDir, &data); err != nil {
+		t.Fatalf("Could not restore backup: %v", err)
+	}
+	restored, err := db.NewDB(restoreDir)
+	if err != nil {
+		t.Fatalf("Could not open restored DB: %v", err)
+	}
+	defer restored.Close()
+	head, err := restored.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head.Slot != block.Slot {
+		t.Errorf("Expected restored head at slot %d, received %d", block.Slot, head.Slot)
+	}
+}
+
+func TestBackup_SendFailure(t *testing.T) {
+	dir := path.Join(testutil.TempDir(), "admin-backup-failure")
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+	beaconDB, err := db.NewDB(dir)
+	if err != nil {
+		t.Fatalf("Could not setup DB: %v", err)
+	}
+	defer internal.TeardownDB(t, beaconDB)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := internal.NewMockAdminService_BackupServer(ctrl)
+	mockStream.EXPECT().Send(gomock.Any()).Return(errors.New("stream closed"))
+
+	adminServer := &AdminServer{beaconDB: beaconDB}
+	if err := adminServer.Backup(&ptypes.Empty{}, mockStream); err == nil {
+		t.Error("Expected backup to fail when the stream fails")
+	}
+}
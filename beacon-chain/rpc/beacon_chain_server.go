@@ -0,0 +1,569 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/stategenerator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultPageSize is the number of items of a page when a request does not set it.
+	defaultPageSize = 20
+	// maxPageSize is the largest number of items returned in a page.
+	maxPageSize = 250
+	// maxScannedSlots is the largest number of slots looked up for a page of blocks, so that
+	// a page spanning many skipped slots returns early with a next page token.
+	maxScannedSlots = 1024
+	// maxPerformanceEpochs is the largest number of epochs of a validator performance request.
+	maxPerformanceEpochs = 64
+	// chainEventsBuf is the number of chain updates buffered for each chain events stream.
+	// A stream falling further behind is disconnected, as the chain service never waits for it.
+	chainEventsBuf = 64
+)
+
+// BeaconChainServer defines a server implementation of the gRPC Beacon Chain service,
+// providing read access to the blocks, states and validators of the beacon chain.
+type BeaconChainServer struct {
+	ctx            context.Context
+	beaconDB       *db.BeaconDB
+	chainService   chainService
+	stateGenerator *stategenerator.StateGenerator
+}
+
+// ListBlocks returns the main chain blocks between the start and end slots of the request,
+// inclusive. The start slot is raised to the genesis slot, and the end slot is capped at the
+// chain head, which is also used if the end slot is not set. Skipped slots are not counted in
+// the page size, but a page ends after maxScannedSlots slots even if it is not full. The next
+// page token is empty once the range is exhausted.
+func (bs *BeaconChainServer) ListBlocks(ctx context.Context, req *pb.ListBlocksRequest) (*pb.ListBlocksResponse, error) {
+	pageSize, err := pageSizeOf(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	head, err := bs.beaconDB.ChainHead()
+	if err != nil {
+		return nil, fmt.Errorf("could not get chain head: %v", err)
+	}
+	startSlot := req.StartSlot
+	if startSlot < params.BeaconConfig().GenesisSlot {
+		startSlot = params.BeaconConfig().GenesisSlot
+	}
+	if req.PageToken != "" {
+		startSlot, err = strconv.ParseUint(req.PageToken, 10, 64)
+		if err != nil || startSlot < req.StartSlot {
+			return nil, fmt.Errorf("invalid page token %q", req.PageToken)
+		}
+	}
+	endSlot := req.EndSlot
+	if endSlot == 0 || endSlot > head.Slot {
+		endSlot = head.Slot
+	}
+
+	res := &pb.ListBlocksResponse{}
+	for slot := startSlot; slot <= endSlot; slot++ {
+		if len(res.Blocks) == pageSize || slot-startSlot == maxScannedSlots {
+			res.NextPageToken = strconv.FormatUint(slot, 10)
+			break
+		}
+		block, err := bs.mainChainBlock(slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not get block at slot %d: %v", slot, err)
+		}
+		if block != nil {
+			res.Blocks = append(res.Blocks, block)
+		}
+	}
+	return res, nil
+}
+
+// BlockByRoot returns the block with the given root, which does not have to be in the main chain.
+func (bs *BeaconChainServer) BlockByRoot(ctx context.Context, req *pb.BlockByRootRequest) (*pbp2p.BeaconBlock, error) {
+	if len(req.BlockRoot) != 32 {
+		return nil, fmt.Errorf("expected a block root of 32 bytes, received %d", len(req.BlockRoot))
+	}
+	block, err := bs.beaconDB.Block(bytesutil.ToBytes32(req.BlockRoot))
+	if err != nil {
+		return nil, fmt.Errorf("could not get block: %v", err)
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block %#x not found", req.BlockRoot)
+	}
+	return block, nil
+}
+
+// BeaconState returns the post-state of the block with the root of the request if it is set,
+// or else the state at the slot of the request on the main chain. States which were not
+// stored in full are regenerated from the closest stored state.
+func (bs *BeaconChainServer) BeaconState(ctx context.Context, req *pb.BeaconStateRequest) (*pbp2p.BeaconState, error) {
+	var beaconState *pbp2p.BeaconState
+	var err error
+	if len(req.BlockRoot) > 0 {
+		beaconState, err = bs.stateByRoot(ctx, req.BlockRoot)
+	} else {
+		beaconState, err = bs.stateAtSlot(ctx, req.Slot)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Fields) == 0 {
+		return beaconState, nil
+	}
+	return selectStateFields(beaconState, req.Fields)
+}
+
+// ListValidators returns the validators of the head state along with their balance and their
+// status at the current epoch, filtered by the statuses of the request.
+func (bs *BeaconChainServer) ListValidators(ctx context.Context, req *pb.ListValidatorsRequest) (*pb.ListValidatorsResponse, error) {
+	pageSize, err := pageSizeOf(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	start := uint64(0)
+	if req.PageToken != "" {
+		start, err = strconv.ParseUint(req.PageToken, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid page token %q", req.PageToken)
+		}
+	}
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get head state: %v", err)
+	}
+	statuses := make(map[pb.ValidatorStatus]bool, len(req.Statuses))
+	for _, status := range req.Statuses {
+		statuses[status] = true
+	}
+
+	res := &pb.ListValidatorsResponse{}
+	epoch := helpers.CurrentEpoch(beaconState)
+	for i, validator := range beaconState.ValidatorRegistry {
+		status := validatorStatus(validator, epoch)
+		if len(statuses) > 0 && !statuses[status] {
+			continue
+		}
+		res.TotalSize++
+		if uint64(i) < start {
+			continue
+		}
+		if len(res.Validators) == pageSize {
+			if res.NextPageToken == "" {
+				res.NextPageToken = strconv.Itoa(i)
+			}
+			continue
+		}
+		res.Validators = append(res.Validators, &pb.ValidatorInfo{
+			Index:     uint64(i),
+			Validator: validator,
+			Balance:   beaconState.ValidatorBalances[i],
+			Status:    status,
+		})
+	}
+	return res, nil
+}
+
+// Checkpoints returns the justified and finalized epochs of the head state, along with the
+// roots of the main chain blocks at their start slots.
+func (bs *BeaconChainServer) Checkpoints(ctx context.Context, _ *ptypes.Empty) (*pb.CheckpointsResponse, error) {
+	head, err := bs.beaconDB.ChainHead()
+	if err != nil {
+		return nil, fmt.Errorf("could not get chain head: %v", err)
+	}
+	headRoot, err := hashutil.HashBeaconBlock(head)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash chain head: %v", err)
+	}
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get head state: %v", err)
+	}
+	justifiedRoot, err := checkpointRoot(beaconState, headRoot, beaconState.JustifiedEpoch)
+	if err != nil {
+		return nil, fmt.Errorf("could not get justified block root: %v", err)
+	}
+	finalizedRoot, err := checkpointRoot(beaconState, headRoot, beaconState.FinalizedEpoch)
+	if err != nil {
+		return nil, fmt.Errorf("could not get finalized block root: %v", err)
+	}
+	return &pb.CheckpointsResponse{
+		JustifiedEpoch:           beaconState.JustifiedEpoch,
+		JustifiedBlockRootHash32: justifiedRoot,
+		FinalizedEpoch:           beaconState.FinalizedEpoch,
+		FinalizedBlockRootHash32: finalizedRoot,
+	}, nil
+}
+
+// ValidatorPerformance returns the reward and penalty breakdown of the requested validators
+// for the epochs of the range whose transition was processed by the main chain. The epochs of
+// the range are raised to the genesis epoch, and the end epoch of the range is included, and
+// capped at the epoch of the chain head.
+func (bs *BeaconChainServer) ValidatorPerformance(ctx context.Context, req *pb.ValidatorPerformanceRequest) (*pb.ValidatorPerformanceResponse, error) {
+	if len(req.PublicKeys) == 0 {
+		return nil, errors.New("no validator public key requested")
+	}
+	startEpoch, endEpoch := req.StartEpoch, req.EndEpoch
+	if genesisEpoch := params.BeaconConfig().GenesisEpoch; startEpoch < genesisEpoch {
+		startEpoch = genesisEpoch
+		if endEpoch < genesisEpoch {
+			endEpoch = genesisEpoch
+		}
+	}
+	if endEpoch < startEpoch {
+		return nil, fmt.Errorf("end epoch %d is before start epoch %d", endEpoch, startEpoch)
+	}
+	if endEpoch-startEpoch >= maxPerformanceEpochs {
+		return nil, fmt.Errorf("requested %d epochs, more than the maximum of %d",
+			endEpoch-startEpoch+1, maxPerformanceEpochs)
+	}
+	res := &pb.ValidatorPerformanceResponse{}
+	for _, pubKey := range req.PublicKeys {
+		index, err := bs.beaconDB.ValidatorIndex(pubKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not get validator index: %v", err)
+		}
+		res.ValidatorIndices = append(res.ValidatorIndices, index)
+	}
+
+	head, err := bs.beaconDB.ChainHead()
+	if err != nil {
+		return nil, fmt.Errorf("could not get chain head: %v", err)
+	}
+	if headEpoch := helpers.SlotToEpoch(head.Slot); endEpoch > headEpoch {
+		endEpoch = headEpoch
+	}
+	for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+		// The transition at the end of an epoch is recorded under the latest block of the
+		// chain at the last slot of the epoch.
+		lastSlot := helpers.StartSlot(epoch+1) - 1
+		if lastSlot > head.Slot {
+			continue
+		}
+		root, err := bs.latestMainChainRoot(lastSlot)
+		if err != nil {
+			return nil, fmt.Errorf("could not get main chain block of epoch %d: %v", epoch, err)
+		}
+		performance, err := bs.beaconDB.EpochPerformance(root, epoch)
+		if err != nil {
+			return nil, fmt.Errorf("could not get performance of epoch %d: %v", epoch, err)
+		}
+		if performance == nil {
+			continue
+		}
+		selected := &pbp2p.EpochPerformance{Epoch: epoch}
+		for _, index := range res.ValidatorIndices {
+			if index < uint64(len(performance.Validators)) {
+				selected.Validators = append(selected.Validators, performance.Validators[index])
+			}
+		}
+		res.Epochs = append(res.Epochs, selected)
+	}
+	return res, nil
+}
+
+// StreamChainEvents streams the events of the canonical chain with one of the types of the
+// request, or all of them if the request has no type. Attestations and voluntary exits are
+// streamed as they are processed in new head blocks. The chain updates are relayed without
+// ever blocking the chain service, and a client which does not keep up with them is
+// disconnected with codes.ResourceExhausted.
+func (bs *BeaconChainServer) StreamChainEvents(req *pb.ChainEventsRequest, stream pb.BeaconChainService_StreamChainEventsServer) error {
+	types := make(map[pb.ChainEventType]bool, len(req.Types))
+	for _, t := range req.Types {
+		types[t] = true
+	}
+	send := func(event *pb.ChainEvent) error {
+		if len(types) > 0 && !types[event.Type] {
+			return nil
+		}
+		return stream.Send(event)
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	relay := newChainEventRelay(chainEventsBuf)
+	blockChan := make(chan *pbp2p.BeaconBlock, 1)
+	blockSub := bs.chainService.CanonicalBlockFeed().Subscribe(blockChan)
+	defer blockSub.Unsubscribe()
+	reorgChan := make(chan *blockchain.Reorg, 1)
+	reorgSub := bs.chainService.ReorgFeed().Subscribe(reorgChan)
+	defer reorgSub.Unsubscribe()
+	checkpointChan := make(chan *blockchain.CheckpointUpdate, 1)
+	checkpointSub := bs.chainService.CheckpointFeed().Subscribe(checkpointChan)
+	defer checkpointSub.Unsubscribe()
+	go func() {
+		for {
+			select {
+			case block := <-blockChan:
+				relay.push(block)
+			case reorg := <-reorgChan:
+				relay.push(reorg)
+			case update := <-checkpointChan:
+				relay.push(update)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for {
+		var err error
+		select {
+		case update := <-relay.updates:
+			switch update := update.(type) {
+			case *pbp2p.BeaconBlock:
+				err = sendBlockEvents(update, send)
+			case *blockchain.Reorg:
+				err = send(reorgEvent(update))
+			case *blockchain.CheckpointUpdate:
+				err = sendCheckpointEvents(update, send)
+			}
+		case <-relay.overflow:
+			log.Debug("Chain events stream closed as the client fell behind")
+			return status.Error(codes.ResourceExhausted, "chain events stream fell behind the chain")
+		case <-blockSub.Err():
+			return errors.New("canonical block subscriber closed")
+		case <-reorgSub.Err():
+			return errors.New("reorg subscriber closed")
+		case <-checkpointSub.Err():
+			return errors.New("checkpoint subscriber closed")
+		case <-stream.Context().Done():
+			log.Debug("Chain events stream closed by client")
+			return nil
+		case <-bs.ctx.Done():
+			log.Debug("RPC context closed, exiting goroutine")
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("could not send chain event: %v", err)
+		}
+	}
+}
+
+// chainEventRelay queues the chain updates of a chain events stream. Updates are pushed
+// without blocking, and the overflow channel is closed once an update is dropped because
+// the queue is full.
+type chainEventRelay struct {
+	updates      chan interface{}
+	overflow     chan struct{}
+	overflowOnce sync.Once
+}
+
+func newChainEventRelay(size int) *chainEventRelay {
+	return &chainEventRelay{
+		updates:  make(chan interface{}, size),
+		overflow: make(chan struct{}),
+	}
+}
+
+func (r *chainEventRelay) push(update interface{}) {
+	select {
+	case r.updates <- update:
+	default:
+		r.overflowOnce.Do(func() {
+			close(r.overflow)
+		})
+	}
+}
+
+// reorgEvent returns the event of a reorg, which carries the attestations and voluntary
+// exits of the abandoned branch as they are no longer part of the canonical chain.
+func reorgEvent(reorg *blockchain.Reorg) *pb.ChainEvent {
+	event := &pb.ReorgEvent{
+		OldHead:        reorg.OldHead,
+		NewHead:        reorg.NewHead,
+		CommonAncestor: reorg.CommonAncestor,
+	}
+	for _, block := range reorg.Orphaned {
+		if block.Body == nil {
+			continue
+		}
+		event.OrphanedAttestations = append(event.OrphanedAttestations, block.Body.Attestations...)
+		event.OrphanedVoluntaryExits = append(event.OrphanedVoluntaryExits, block.Body.VoluntaryExits...)
+	}
+	return &pb.ChainEvent{Type: pb.ChainEventType_REORG, Reorg: event}
+}
+
+// sendBlockEvents sends the event of a new head block, followed by the events of the
+// attestations and voluntary exits it processed.
+func sendBlockEvents(block *pbp2p.BeaconBlock, send func(*pb.ChainEvent) error) error {
+	if err := send(&pb.ChainEvent{Type: pb.ChainEventType_NEW_HEAD, Head: block}); err != nil {
+		return err
+	}
+	if block.Body == nil {
+		return nil
+	}
+	for _, att := range block.Body.Attestations {
+		if err := send(&pb.ChainEvent{Type: pb.ChainEventType_ATTESTATION, Attestation: att}); err != nil {
+			return err
+		}
+	}
+	for _, exit := range block.Body.VoluntaryExits {
+		if err := send(&pb.ChainEvent{Type: pb.ChainEventType_VOLUNTARY_EXIT, VoluntaryExit: exit}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sendCheckpointEvents sends an event for each of the justified and finalized epochs which
+// changed in the update.
+func sendCheckpointEvents(update *blockchain.CheckpointUpdate, send func(*pb.ChainEvent) error) error {
+	if update.JustifiedEpoch != update.OldJustifiedEpoch {
+		if err := send(&pb.ChainEvent{Type: pb.ChainEventType_JUSTIFIED, Epoch: update.JustifiedEpoch}); err != nil {
+			return err
+		}
+	}
+	if update.FinalizedEpoch != update.OldFinalizedEpoch {
+		return send(&pb.ChainEvent{Type: pb.ChainEventType_FINALIZED, Epoch: update.FinalizedEpoch})
+	}
+	return nil
+}
+
+// mainChainBlock returns the main chain block at the given slot, or nil if the slot was
+// skipped. The genesis block of an initialized chain is recorded in the main chain under
+// slot 0.
+func (bs *BeaconChainServer) mainChainBlock(slot uint64) (*pbp2p.BeaconBlock, error) {
+	block, err := bs.beaconDB.BlockBySlot(slot)
+	if err != nil || block != nil || slot != params.BeaconConfig().GenesisSlot {
+		return block, err
+	}
+	return bs.beaconDB.BlockBySlot(0)
+}
+
+// latestMainChainRoot returns the root of the latest main chain block at or before the
+// given slot.
+func (bs *BeaconChainServer) latestMainChainRoot(slot uint64) ([32]byte, error) {
+	for s := slot; s >= params.BeaconConfig().GenesisSlot; s-- {
+		block, err := bs.mainChainBlock(s)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		if block != nil {
+			return hashutil.HashBeaconBlock(block)
+		}
+	}
+	return [32]byte{}, fmt.Errorf("no main chain block at or before slot %d", slot-params.BeaconConfig().GenesisSlot)
+}
+
+func (bs *BeaconChainServer) stateByRoot(ctx context.Context, blockRoot []byte) (*pbp2p.BeaconState, error) {
+	if len(blockRoot) != 32 {
+		return nil, fmt.Errorf("expected a block root of 32 bytes, received %d", len(blockRoot))
+	}
+	root := bytesutil.ToBytes32(blockRoot)
+	beaconState, err := bs.beaconDB.StateByRoot(root)
+	if err != nil {
+		return nil, fmt.Errorf("could not get state: %v", err)
+	}
+	if beaconState != nil {
+		return beaconState, nil
+	}
+	block, err := bs.beaconDB.Block(root)
+	if err != nil {
+		return nil, fmt.Errorf("could not get block: %v", err)
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block %#x not found", blockRoot)
+	}
+	return bs.regenerateState(ctx, root, block.Slot)
+}
+
+func (bs *BeaconChainServer) stateAtSlot(ctx context.Context, slot uint64) (*pbp2p.BeaconState, error) {
+	head, err := bs.beaconDB.ChainHead()
+	if err != nil {
+		return nil, fmt.Errorf("could not get chain head: %v", err)
+	}
+	if slot > head.Slot {
+		return nil, fmt.Errorf("slot %d is after the chain head at slot %d", slot, head.Slot)
+	}
+	if slot == head.Slot {
+		beaconState, err := bs.beaconDB.HeadState(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get head state: %v", err)
+		}
+		return beaconState, nil
+	}
+	headRoot, err := hashutil.HashBeaconBlock(head)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash chain head: %v", err)
+	}
+	return bs.regenerateState(ctx, headRoot, slot)
+}
+
+// regenerateState regenerates the state at the given slot on the chain of the given block.
+// It fails with codes.Unavailable if the state generator could not be created at startup.
+func (bs *BeaconChainServer) regenerateState(ctx context.Context, blockRoot [32]byte, slot uint64) (*pbp2p.BeaconState, error) {
+	if bs.stateGenerator == nil {
+		return nil, status.Error(codes.Unavailable, "state regeneration is not available on this node")
+	}
+	beaconState, err := bs.stateGenerator.StateAtSlot(ctx, blockRoot, slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not regenerate state: %v", err)
+	}
+	return beaconState, nil
+}
+
+// checkpointRoot returns the root of the block at the start slot of the given epoch on the
+// chain of the head state.
+func checkpointRoot(beaconState *pbp2p.BeaconState, headRoot [32]byte, epoch uint64) ([]byte, error) {
+	slot := helpers.StartSlot(epoch)
+	if slot >= beaconState.Slot {
+		return headRoot[:], nil
+	}
+	return blocks.BlockRoot(beaconState, slot)
+}
+
+// selectStateFields returns a state with only the given fields of the state set. Fields are
+// named as in the protobuf definition of the state.
+func selectStateFields(beaconState *pbp2p.BeaconState, fields []string) (*pbp2p.BeaconState, error) {
+	src := reflect.ValueOf(beaconState).Elem()
+	selected := &pbp2p.BeaconState{}
+	dst := reflect.ValueOf(selected).Elem()
+	for _, field := range fields {
+		i, ok := stateFieldIndex(src.Type(), field)
+		if !ok {
+			return nil, fmt.Errorf("unknown state field %q", field)
+		}
+		dst.Field(i).Set(src.Field(i))
+	}
+	return selected, nil
+}
+
+// stateFieldIndex returns the index of the struct field of the state with the given
+// protobuf name.
+func stateFieldIndex(stateType reflect.Type, name string) (int, bool) {
+	for i := 0; i < stateType.NumField(); i++ {
+		for _, opt := range strings.Split(stateType.Field(i).Tag.Get("protobuf"), ",") {
+			if opt == "name="+name {
+				return i, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// pageSizeOf returns the page size of a request, which falls back to the default one if unset.
+func pageSizeOf(requested uint32) (int, error) {
+	if requested > maxPageSize {
+		return 0, fmt.Errorf("requested page size %d is larger than the maximum of %d", requested, maxPageSize)
+	}
+	if requested == 0 {
+		return defaultPageSize, nil
+	}
+	return int(requested), nil
+}
@@ -0,0 +1,555 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/stategenerator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// setupBeaconChain saves a main chain with blocks at the given slots after genesis, and
+// returns a server on top of it along with the blocks.
+func setupBeaconChain(t *testing.T, beaconDB *db.BeaconDB, slots []uint64) (*BeaconChainServer, []*pbp2p.BeaconBlock) {
+	beaconDB.SetStateStoragePolicy(db.StoreAllStates)
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	var chain []*pbp2p.BeaconBlock
+	parentRoot := []byte{}
+	for _, slot := range slots {
+		block := &pbp2p.BeaconBlock{
+			Slot:             genesisSlot + slot,
+			ParentRootHash32: parentRoot,
+		}
+		beaconState := &pbp2p.BeaconState{
+			Slot:           genesisSlot + slot,
+			JustifiedEpoch: params.BeaconConfig().GenesisEpoch,
+			FinalizedEpoch: params.BeaconConfig().GenesisEpoch,
+		}
+		if err := beaconDB.SaveBlock(block); err != nil {
+			t.Fatalf("Could not save block: %v", err)
+		}
+		if err := beaconDB.UpdateChainHead(block, beaconState); err != nil {
+			t.Fatalf("Could not update chain head: %v", err)
+		}
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := beaconDB.SaveStateForBlock(root, beaconState); err != nil {
+			t.Fatalf("Could not save state: %v", err)
+		}
+		parentRoot = root[:]
+		chain = append(chain, block)
+	}
+	generator, err := stategenerator.NewStateGenerator(beaconDB, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &BeaconChainServer{beaconDB: beaconDB, stateGenerator: generator}, chain
+}
+
+func TestListBlocks_Pagination(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	server, chain := setupBeaconChain(t, db, []uint64{0, 1, 2, 4, 5})
+
+	req := &pb.ListBlocksRequest{
+		StartSlot: params.BeaconConfig().GenesisSlot + 1,
+		EndSlot:   params.BeaconConfig().GenesisSlot + 4,
+		PageSize:  2,
+	}
+	res, err := server.ListBlocks(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Could not list blocks: %v", err)
+	}
+	if len(res.Blocks) != 2 || !proto.Equal(res.Blocks[0], chain[1]) || !proto.Equal(res.Blocks[1], chain[2]) {
+		t.Errorf("Expected the blocks at slots 1 and 2, received %v", res.Blocks)
+	}
+	if res.NextPageToken == "" {
+		t.Fatal("Expected a next page token")
+	}
+
+	req.PageToken = res.NextPageToken
+	res, err = server.ListBlocks(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Could not list blocks: %v", err)
+	}
+	if len(res.Blocks) != 1 || !proto.Equal(res.Blocks[0], chain[3]) {
+		t.Errorf("Expected the block at slot 4, received %v", res.Blocks)
+	}
+	if res.NextPageToken != "" {
+		t.Errorf("Expected no next page token, received %q", res.NextPageToken)
+	}
+}
+
+func TestListBlocks_PageSizeTooLarge(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	server, _ := setupBeaconChain(t, db, []uint64{0})
+
+	want := "larger than the maximum"
+	if _, err := server.ListBlocks(context.Background(), &pb.ListBlocksRequest{PageSize: maxPageSize + 1}); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
+func TestListBlocks_ClampsStartSlotToGenesis(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	server, chain := setupBeaconChain(t, db, []uint64{0, 1})
+
+	res, err := server.ListBlocks(context.Background(), &pb.ListBlocksRequest{})
+	if err != nil {
+		t.Fatalf("Could not list blocks: %v", err)
+	}
+	if len(res.Blocks) != 2 || !proto.Equal(res.Blocks[0], chain[0]) || !proto.Equal(res.Blocks[1], chain[1]) {
+		t.Errorf("Expected the blocks at slots 0 and 1, received %v", res.Blocks)
+	}
+	if res.NextPageToken != "" {
+		t.Errorf("Expected no next page token, received %q", res.NextPageToken)
+	}
+}
+
+func TestListBlocks_CapsScannedSlots(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	server, chain := setupBeaconChain(t, db, []uint64{0, maxScannedSlots + 1})
+
+	req := &pb.ListBlocksRequest{
+		StartSlot: params.BeaconConfig().GenesisSlot + 1,
+	}
+	res, err := server.ListBlocks(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Could not list blocks: %v", err)
+	}
+	if len(res.Blocks) != 0 {
+		t.Errorf("Expected no block in the first %d slots, received %v", maxScannedSlots, res.Blocks)
+	}
+	if res.NextPageToken == "" {
+		t.Fatal("Expected a next page token")
+	}
+
+	req.PageToken = res.NextPageToken
+	res, err = server.ListBlocks(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Could not list blocks: %v", err)
+	}
+	if len(res.Blocks) != 1 || !proto.Equal(res.Blocks[0], chain[1]) {
+		t.Errorf("Expected the block at slot %d, received %v", maxScannedSlots+1, res.Blocks)
+	}
+}
+
+func TestBlockByRoot_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	server, chain := setupBeaconChain(t, db, []uint64{0, 1})
+
+	root, err := hashutil.HashBeaconBlock(chain[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := server.BlockByRoot(context.Background(), &pb.BlockByRootRequest{BlockRoot: root[:]})
+	if err != nil {
+		t.Fatalf("Could not get block: %v", err)
+	}
+	if !proto.Equal(block, chain[1]) {
+		t.Errorf("Expected block %v, received %v", chain[1], block)
+	}
+
+	want := "not found"
+	if _, err := server.BlockByRoot(context.Background(), &pb.BlockByRootRequest{BlockRoot: make([]byte, 32)}); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
+func TestBeaconState_BySlotAndRoot(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	server, chain := setupBeaconChain(t, db, []uint64{0, 1, 2})
+
+	// States before the chain head are looked up by the state generator.
+	beaconState, err := server.BeaconState(context.Background(), &pb.BeaconStateRequest{
+		Slot: params.BeaconConfig().GenesisSlot + 1,
+	})
+	if err != nil {
+		t.Fatalf("Could not get state: %v", err)
+	}
+	if beaconState.Slot != chain[1].Slot {
+		t.Errorf("Expected state at slot %d, received %d", chain[1].Slot, beaconState.Slot)
+	}
+
+	root, err := hashutil.HashBeaconBlock(chain[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState, err = server.BeaconState(context.Background(), &pb.BeaconStateRequest{BlockRoot: root[:]})
+	if err != nil {
+		t.Fatalf("Could not get state: %v", err)
+	}
+	if beaconState.Slot != chain[2].Slot {
+		t.Errorf("Expected state at slot %d, received %d", chain[2].Slot, beaconState.Slot)
+	}
+
+	want := "after the chain head"
+	if _, err := server.BeaconState(context.Background(), &pb.BeaconStateRequest{
+		Slot: params.BeaconConfig().GenesisSlot + 3,
+	}); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
+func TestBeaconState_UnavailableWithoutStateGenerator(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	server, _ := setupBeaconChain(t, db, []uint64{0, 1, 2})
+	server.stateGenerator = nil
+
+	_, err := server.BeaconState(context.Background(), &pb.BeaconStateRequest{
+		Slot: params.BeaconConfig().GenesisSlot + 1,
+	})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected an unavailable error, received %v", err)
+	}
+}
+
+func TestBeaconState_SelectsFields(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	server, chain := setupBeaconChain(t, db, []uint64{0})
+
+	beaconState, err := server.BeaconState(context.Background(), &pb.BeaconStateRequest{
+		Slot:   chain[0].Slot,
+		Fields: []string{"slot", "finalized_epoch"},
+	})
+	if err != nil {
+		t.Fatalf("Could not get state: %v", err)
+	}
+	want := &pbp2p.BeaconState{
+		Slot:           chain[0].Slot,
+		FinalizedEpoch: params.BeaconConfig().GenesisEpoch,
+	}
+	if !proto.Equal(beaconState, want) {
+		t.Errorf("Expected state %v, received %v", want, beaconState)
+	}
+
+	wantErr := "unknown state field"
+	if _, err := server.BeaconState(context.Background(), &pb.BeaconStateRequest{
+		Slot:   chain[0].Slot,
+		Fields: []string{"balances"},
+	}); err == nil || !strings.Contains(err.Error(), wantErr) {
+		t.Errorf("Expected error containing %q, received %v", wantErr, err)
+	}
+}
+
+func TestListValidators_FiltersByStatus(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	beaconState := &pbp2p.BeaconState{
+		Slot: params.BeaconConfig().GenesisSlot,
+		ValidatorRegistry: []*pbp2p.Validator{
+			{Pubkey: []byte{0}, ActivationEpoch: genesisEpoch, ExitEpoch: farFuture},
+			{Pubkey: []byte{1}, ActivationEpoch: farFuture, ExitEpoch: farFuture},
+			{Pubkey: []byte{2}, ActivationEpoch: genesisEpoch, ExitEpoch: farFuture},
+			{Pubkey: []byte{3}, ActivationEpoch: genesisEpoch, ExitEpoch: farFuture},
+		},
+		ValidatorBalances: []uint64{10, 11, 12, 13},
+	}
+	block := &pbp2p.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot}
+	if err := db.SaveBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(block, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	server := &BeaconChainServer{beaconDB: db}
+
+	req := &pb.ListValidatorsRequest{
+		Statuses: []pb.ValidatorStatus{pb.ValidatorStatus_ACTIVE},
+		PageSize: 2,
+	}
+	res, err := server.ListValidators(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Could not list validators: %v", err)
+	}
+	if res.TotalSize != 3 {
+		t.Errorf("Expected 3 active validators, received %d", res.TotalSize)
+	}
+	if len(res.Validators) != 2 || res.Validators[0].Index != 0 || res.Validators[1].Index != 2 {
+		t.Fatalf("Expected validators 0 and 2, received %v", res.Validators)
+	}
+	if res.Validators[1].Balance != 12 || res.Validators[1].Status != pb.ValidatorStatus_ACTIVE {
+		t.Errorf("Expected balance 12 and active status, received %v", res.Validators[1])
+	}
+
+	req.PageToken = res.NextPageToken
+	res, err = server.ListValidators(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Could not list validators: %v", err)
+	}
+	if len(res.Validators) != 1 || res.Validators[0].Index != 3 || res.NextPageToken != "" {
+		t.Errorf("Expected validator 3 as the last page, received %v", res)
+	}
+}
+
+func TestCheckpoints_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	server, chain := setupBeaconChain(t, db, []uint64{0})
+
+	res, err := server.Checkpoints(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Could not get checkpoints: %v", err)
+	}
+	genesisRoot, err := hashutil.HashBeaconBlock(chain[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.CheckpointsResponse{
+		JustifiedEpoch:           params.BeaconConfig().GenesisEpoch,
+		JustifiedBlockRootHash32: genesisRoot[:],
+		FinalizedEpoch:           params.BeaconConfig().GenesisEpoch,
+		FinalizedBlockRootHash32: genesisRoot[:],
+	}
+	if !proto.Equal(res, want) {
+		t.Errorf("Expected checkpoints %v, received %v", want, res)
+	}
+}
+
+func TestValidatorPerformance_SelectsValidatorsOfMainChainEpochs(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	// The last slot of the second epoch is skipped.
+	server, chain := setupBeaconChain(t, db, []uint64{0, slotsPerEpoch - 1, 2*slotsPerEpoch - 3, 2*slotsPerEpoch + 1})
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	for i, pubKey := range [][]byte{{'a'}, {'b'}, {'c'}} {
+		if err := db.SaveValidatorIndex(pubKey, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	performances := make([]*pbp2p.EpochPerformance, 2)
+	for i, block := range []*pbp2p.BeaconBlock{chain[1], chain[2]} {
+		performances[i] = &pbp2p.EpochPerformance{Epoch: genesisEpoch + uint64(i)}
+		for index := uint64(0); index < 3; index++ {
+			performances[i].Validators = append(performances[i].Validators, &pbp2p.ValidatorPerformance{
+				ValidatorIndex: index,
+				FfgSourceDelta: int64(i) - int64(index),
+			})
+		}
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveEpochPerformance(root, performances[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	res, err := server.ValidatorPerformance(context.Background(), &pb.ValidatorPerformanceRequest{
+		PublicKeys: [][]byte{{'c'}, {'a'}},
+		StartEpoch: genesisEpoch,
+		EndEpoch:   genesisEpoch + 5,
+	})
+	if err != nil {
+		t.Fatalf("Could not get validator performance: %v", err)
+	}
+	want := &pb.ValidatorPerformanceResponse{ValidatorIndices: []uint64{2, 0}}
+	for _, performance := range performances {
+		want.Epochs = append(want.Epochs, &pbp2p.EpochPerformance{
+			Epoch:      performance.Epoch,
+			Validators: []*pbp2p.ValidatorPerformance{performance.Validators[2], performance.Validators[0]},
+		})
+	}
+	if !proto.Equal(res, want) {
+		t.Errorf("Expected %v, received %v", want, res)
+	}
+
+	// Epochs before genesis are raised to the genesis epoch.
+	res, err = server.ValidatorPerformance(context.Background(), &pb.ValidatorPerformanceRequest{
+		PublicKeys: [][]byte{{'c'}, {'a'}},
+		EndEpoch:   genesisEpoch + 5,
+	})
+	if err != nil {
+		t.Fatalf("Could not get validator performance from epoch 0: %v", err)
+	}
+	if !proto.Equal(res, want) {
+		t.Errorf("Expected %v, received %v", want, res)
+	}
+
+	for _, tt := range []struct {
+		req     *pb.ValidatorPerformanceRequest
+		wantErr string
+	}{
+		{
+			req:     &pb.ValidatorPerformanceRequest{StartEpoch: genesisEpoch, EndEpoch: genesisEpoch},
+			wantErr: "no validator public key requested",
+		},
+		{
+			req:     &pb.ValidatorPerformanceRequest{PublicKeys: [][]byte{{'a'}}, StartEpoch: genesisEpoch + 1, EndEpoch: genesisEpoch},
+			wantErr: "is before start epoch",
+		},
+		{
+			req:     &pb.ValidatorPerformanceRequest{PublicKeys: [][]byte{{'a'}}, StartEpoch: genesisEpoch, EndEpoch: genesisEpoch + maxPerformanceEpochs},
+			wantErr: "more than the maximum",
+		},
+		{
+			req:     &pb.ValidatorPerformanceRequest{PublicKeys: [][]byte{{'d'}}, StartEpoch: genesisEpoch, EndEpoch: genesisEpoch},
+			wantErr: "could not get validator index",
+		},
+	} {
+		if _, err := server.ValidatorPerformance(context.Background(), tt.req); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("Expected error %q, received %v", tt.wantErr, err)
+		}
+	}
+}
+
+func TestStreamChainEvents_FiltersByType(t *testing.T) {
+	chainService := newMockChainService()
+	ctx, cancel := context.WithCancel(context.Background())
+	server := &BeaconChainServer{ctx: ctx, chainService: chainService}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := internal.NewMockBeaconChainService_StreamChainEventsServer(ctrl)
+	mockStream.EXPECT().Context().Return(context.Background()).AnyTimes()
+	received := make(chan *pb.ChainEvent, 2)
+	mockStream.EXPECT().Send(gomock.Any()).Do(func(chainEvent *pb.ChainEvent) {
+		received <- chainEvent
+	}).Return(nil).Times(2)
+
+	exitRoutine := make(chan error)
+	go func() {
+		exitRoutine <- server.StreamChainEvents(&pb.ChainEventsRequest{
+			Types: []pb.ChainEventType{pb.ChainEventType_ATTESTATION, pb.ChainEventType_FINALIZED},
+		}, mockStream)
+	}()
+
+	attestation := &pbp2p.Attestation{Data: &pbp2p.AttestationData{Slot: params.BeaconConfig().GenesisSlot}}
+	block := &pbp2p.BeaconBlock{
+		Slot: params.BeaconConfig().GenesisSlot + 1,
+		Body: &pbp2p.BeaconBlockBody{
+			Attestations:   []*pbp2p.Attestation{attestation},
+			VoluntaryExits: []*pbp2p.VoluntaryExit{{ValidatorIndex: 1}},
+		},
+	}
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	sendWhenSubscribed(t, chainService.blockFeed, block)
+	sendWhenSubscribed(t, chainService.checkpointFeed, &blockchain.CheckpointUpdate{
+		OldJustifiedEpoch: genesisEpoch + 1,
+		JustifiedEpoch:    genesisEpoch + 2,
+		OldFinalizedEpoch: genesisEpoch,
+		FinalizedEpoch:    genesisEpoch + 1,
+	})
+
+	var attestationEvent, finalizedEvent *pb.ChainEvent
+	for i := 0; i < 2; i++ {
+		chainEvent := <-received
+		switch chainEvent.Type {
+		case pb.ChainEventType_ATTESTATION:
+			attestationEvent = chainEvent
+		case pb.ChainEventType_FINALIZED:
+			finalizedEvent = chainEvent
+		default:
+			t.Errorf("Received unrequested event %v", chainEvent)
+		}
+	}
+	if attestationEvent == nil || !proto.Equal(attestationEvent.Attestation, attestation) {
+		t.Errorf("Expected an attestation event for %v, received %v", attestation, attestationEvent)
+	}
+	if finalizedEvent == nil || finalizedEvent.Epoch != genesisEpoch+1 {
+		t.Errorf("Expected a finalized event for epoch %d, received %v", genesisEpoch+1, finalizedEvent)
+	}
+
+	cancel()
+	if err := <-exitRoutine; err != nil {
+		t.Errorf("Could not stream chain events: %v", err)
+	}
+}
+
+func TestStreamChainEvents_DisconnectsSlowClient(t *testing.T) {
+	chainService := newMockChainService()
+	server := &BeaconChainServer{ctx: context.Background(), chainService: chainService}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := internal.NewMockBeaconChainService_StreamChainEventsServer(ctrl)
+	mockStream.EXPECT().Context().Return(context.Background()).AnyTimes()
+	unblock := make(chan struct{})
+	mockStream.EXPECT().Send(gomock.Any()).Do(func(_ *pb.ChainEvent) {
+		<-unblock
+	}).Return(nil).AnyTimes()
+
+	exitRoutine := make(chan error)
+	go func() {
+		exitRoutine <- server.StreamChainEvents(&pb.ChainEventsRequest{}, mockStream)
+	}()
+
+	// The feed never waits for the stream, which is stuck sending the first event.
+	block := &pbp2p.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 1}
+	sendWhenSubscribed(t, chainService.blockFeed, block)
+	for i := 0; i < chainEventsBuf+2; i++ {
+		chainService.blockFeed.Send(block)
+	}
+	close(unblock)
+
+	if err := <-exitRoutine; status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Expected the slow client to be disconnected, received %v", err)
+	}
+}
+
+func TestReorgEvent_IncludesOrphanedOperations(t *testing.T) {
+	attestation := &pbp2p.Attestation{Data: &pbp2p.AttestationData{Slot: params.BeaconConfig().GenesisSlot + 1}}
+	exit := &pbp2p.VoluntaryExit{ValidatorIndex: 3}
+	reorg := &blockchain.Reorg{
+		OldHead: &pbp2p.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 2},
+		NewHead: &pbp2p.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 3},
+		Orphaned: []*pbp2p.BeaconBlock{
+			{
+				Slot: params.BeaconConfig().GenesisSlot + 2,
+				Body: &pbp2p.BeaconBlockBody{VoluntaryExits: []*pbp2p.VoluntaryExit{exit}},
+			},
+			{
+				Slot: params.BeaconConfig().GenesisSlot + 1,
+				Body: &pbp2p.BeaconBlockBody{Attestations: []*pbp2p.Attestation{attestation}},
+			},
+		},
+	}
+
+	chainEvent := reorgEvent(reorg)
+	if chainEvent.Type != pb.ChainEventType_REORG || !proto.Equal(chainEvent.Reorg.NewHead, reorg.NewHead) {
+		t.Fatalf("Expected a reorg event to %v, received %v", reorg.NewHead, chainEvent)
+	}
+	if len(chainEvent.Reorg.OrphanedAttestations) != 1 || !proto.Equal(chainEvent.Reorg.OrphanedAttestations[0], attestation) {
+		t.Errorf("Expected orphaned attestation %v, received %v", attestation, chainEvent.Reorg.OrphanedAttestations)
+	}
+	if len(chainEvent.Reorg.OrphanedVoluntaryExits) != 1 || !proto.Equal(chainEvent.Reorg.OrphanedVoluntaryExits[0], exit) {
+		t.Errorf("Expected orphaned voluntary exit %v, received %v", exit, chainEvent.Reorg.OrphanedVoluntaryExits)
+	}
+}
+
+// sendWhenSubscribed sends the value over the feed once the stream under test subscribed to it.
+func sendWhenSubscribed(t *testing.T, feed *event.Feed, value interface{}) {
+	for i := 0; i < 100; i++ {
+		if feed.Send(value) > 0 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("Timed out waiting for a subscriber of %T", value)
+}
@@ -8,10 +8,13 @@ import (
 	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -25,6 +28,7 @@ type BeaconServer struct {
 	chainService        chainService
 	chainStartDelayFlag uint64
 	operationService    operationService
+	p2p                 p2p.Broadcaster
 	incomingAttestation chan *pbp2p.Attestation
 	canonicalStateChan  chan *pbp2p.BeaconState
 	chainStartChan      chan time.Time
@@ -106,6 +110,72 @@ func (bs *BeaconServer) ForkData(ctx context.Context, _ *ptypes.Empty) (*pbp2p.F
 	return state.Fork, nil
 }
 
+// SubmitProposerSlashing validates a proposer slashing against the head state, then adds it to
+// the operations pool and broadcasts it to the network.
+func (bs *BeaconServer) SubmitProposerSlashing(ctx context.Context, slashing *pbp2p.ProposerSlashing) (*pb.SubmitSlashingResponse, error) {
+	h, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash proposer slashing: %v", err)
+	}
+	if bs.beaconDB.HasProposerSlashing(h) {
+		return &pb.SubmitSlashingResponse{SlashingHash: h[:]}, nil
+	}
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if err := blocks.VerifyProposerSlashing(beaconState, slashing); err != nil {
+		return nil, fmt.Errorf("invalid proposer slashing: %v", err)
+	}
+	bs.operationService.IncomingProposerSlashingFeed().Send(slashing)
+	bs.p2p.Broadcast(slashing)
+	return &pb.SubmitSlashingResponse{SlashingHash: h[:]}, nil
+}
+
+// SubmitAttesterSlashing validates an attester slashing against the head state, then adds it to
+// the operations pool and broadcasts it to the network.
+func (bs *BeaconServer) SubmitAttesterSlashing(ctx context.Context, slashing *pbp2p.AttesterSlashing) (*pb.SubmitSlashingResponse, error) {
+	h, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash attester slashing: %v", err)
+	}
+	if bs.beaconDB.HasAttesterSlashing(h) {
+		return &pb.SubmitSlashingResponse{SlashingHash: h[:]}, nil
+	}
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if err := blocks.VerifyAttesterSlashing(beaconState, slashing); err != nil {
+		return nil, fmt.Errorf("invalid attester slashing: %v", err)
+	}
+	bs.operationService.IncomingAttesterSlashingFeed().Send(slashing)
+	bs.p2p.Broadcast(slashing)
+	return &pb.SubmitSlashingResponse{SlashingHash: h[:]}, nil
+}
+
+// SubmitVoluntaryExit validates a voluntary exit against the head state, then adds it to the
+// operations pool and broadcasts it to the network.
+func (bs *BeaconServer) SubmitVoluntaryExit(ctx context.Context, exit *pbp2p.VoluntaryExit) (*pb.SubmitExitResponse, error) {
+	h, err := hashutil.HashProto(exit)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash exit: %v", err)
+	}
+	if bs.beaconDB.HasExit(h) {
+		return &pb.SubmitExitResponse{ExitHash: h[:]}, nil
+	}
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if err := blocks.VerifyExit(beaconState, exit); err != nil {
+		return nil, fmt.Errorf("invalid exit: %v", err)
+	}
+	bs.operationService.IncomingExitFeed().Send(exit)
+	bs.p2p.Broadcast(exit)
+	return &pb.SubmitExitResponse{ExitHash: h[:]}, nil
+}
+
 // Eth1Data is a mechanism used by block proposers vote on a recent Ethereum 1.0 block hash and an
 // associated deposit root found in the Ethereum 1.0 deposit contract. When consensus is formed,
 // state.latest_eth1_data is updated, and validator deposits up to this root can be processed.
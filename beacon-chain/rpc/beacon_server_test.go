@@ -3,23 +3,26 @@ package rpc
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"math/big"
-	"reflect"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
-	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/forkutils"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -278,7 +281,8 @@ func TestPendingDeposits_OutsideEth1FollowWindow(t *testing.T) {
 	p := &mockPOWChainService{
 		latestBlockNumber: big.NewInt(int64(10 + params.BeaconConfig().Eth1FollowDistance)),
 	}
-	d := &db.BeaconDB{}
+	d := internal.SetupDB(t)
+	defer internal.TeardownDB(t, d)
 
 	// Using the merkleTreeIndex as the block number for this test...
 	readyDeposits := []*pbp2p.Deposit{
@@ -313,7 +317,7 @@ func TestPendingDeposits_OutsideEth1FollowWindow(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if !reflect.DeepEqual(result.PendingDeposits, readyDeposits) {
+	if !proto.Equal(result, &pb.PendingDepositsResponse{PendingDeposits: readyDeposits}) {
 		t.Errorf("Received unexpected list of deposits: %+v, wanted: %+v", result, readyDeposits)
 	}
 
@@ -528,3 +532,240 @@ func Benchmark_Eth1Data(b *testing.B) {
 		}
 	}
 }
+
+type mockOperationPool struct {
+	mockOperationService
+	exitFeed             *event.Feed
+	proposerSlashingFeed *event.Feed
+	attesterSlashingFeed *event.Feed
+}
+
+func (m *mockOperationPool) IncomingExitFeed() *event.Feed {
+	return m.exitFeed
+}
+
+func (m *mockOperationPool) IncomingProposerSlashingFeed() *event.Feed {
+	return m.proposerSlashingFeed
+}
+
+func (m *mockOperationPool) IncomingAttesterSlashingFeed() *event.Feed {
+	return m.attesterSlashingFeed
+}
+
+func setupOperationPoolTest(t *testing.T) (*BeaconServer, *mockOperationPool, *mockP2P, []*bls.SecretKey) {
+	beaconDB := internal.SetupDB(t)
+	validators := make([]*pbp2p.Validator, 4)
+	privKeys := make([]*bls.SecretKey, len(validators))
+	for i := 0; i < len(validators); i++ {
+		priv, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		privKeys[i] = priv
+		validators[i] = &pbp2p.Validator{
+			Pubkey:       priv.PublicKey().Marshal(),
+			ExitEpoch:    params.BeaconConfig().FarFutureEpoch,
+			SlashedEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	validators[3].SlashedEpoch = params.BeaconConfig().GenesisEpoch
+	beaconState := &pbp2p.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot,
+		Fork:              &pbp2p.Fork{Epoch: params.BeaconConfig().GenesisEpoch},
+		ValidatorRegistry: validators,
+	}
+	block := &pbp2p.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot}
+	if err := beaconDB.SaveBlock(block); err != nil {
+		t.Fatalf("Could not save block: %v", err)
+	}
+	if err := beaconDB.UpdateChainHead(block, beaconState); err != nil {
+		t.Fatalf("Could not update chain head: %v", err)
+	}
+	pool := &mockOperationPool{
+		exitFeed:             new(event.Feed),
+		proposerSlashingFeed: new(event.Feed),
+		attesterSlashingFeed: new(event.Feed),
+	}
+	broadcaster := &mockP2P{}
+	return &BeaconServer{
+		beaconDB:         beaconDB,
+		operationService: pool,
+		p2p:              broadcaster,
+	}, pool, broadcaster, privKeys
+}
+
+// signedProposerSlashing creates a proposer slashing of the given proposer, with both
+// proposals signed by the proposer.
+func signedProposerSlashing(t *testing.T, privKeys []*bls.SecretKey, proposerIdx uint64) *pbp2p.ProposerSlashing {
+	proposal := &pbp2p.ProposalSignedData{Slot: params.BeaconConfig().GenesisSlot + 1, BlockRootHash32: []byte{1}}
+	root, err := hashutil.HashProto(proposal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutils.DomainVersion(&pbp2p.Fork{}, params.BeaconConfig().GenesisEpoch, params.BeaconConfig().DomainProposal)
+	signature := privKeys[proposerIdx].Sign(root[:], domain).Marshal()
+	return &pbp2p.ProposerSlashing{
+		ProposerIndex:       proposerIdx,
+		ProposalData_1:      proposal,
+		ProposalSignature_1: signature,
+		ProposalData_2:      proposal,
+		ProposalSignature_2: signature,
+	}
+}
+
+// signedExit creates a voluntary exit of the given validator at the given epoch, signed by the
+// validator.
+func signedExit(t *testing.T, privKeys []*bls.SecretKey, validatorIdx uint64, epoch uint64) *pbp2p.VoluntaryExit {
+	exit := &pbp2p.VoluntaryExit{ValidatorIndex: validatorIdx, Epoch: epoch}
+	root, err := hashutil.HashProto(exit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutils.DomainVersion(&pbp2p.Fork{}, epoch, params.BeaconConfig().DomainExit)
+	exit.Signature = privKeys[validatorIdx].Sign(root[:], domain).Marshal()
+	return exit
+}
+
+// signSlashableAttestation sets the aggregate signature of a slashable attestation whose
+// custody bits are all set, signed by each of its validators.
+func signSlashableAttestation(t *testing.T, privKeys []*bls.SecretKey, att *pbp2p.SlashableAttestation) {
+	root, err := hashutil.HashProto(&pbp2p.AttestationDataAndCustodyBit{Data: att.Data, CustodyBit: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutils.DomainVersion(&pbp2p.Fork{}, params.BeaconConfig().GenesisEpoch, params.BeaconConfig().DomainAttestation)
+	var sigs []*bls.Signature
+	for _, idx := range att.ValidatorIndices {
+		sigs = append(sigs, privKeys[idx].Sign(root[:], domain))
+	}
+	att.AggregateSignature = bls.AggregateSignatures(sigs).Marshal()
+}
+
+func TestSubmitProposerSlashing_PoolsAndBroadcasts(t *testing.T) {
+	beaconServer, pool, broadcaster, privKeys := setupOperationPoolTest(t)
+	defer internal.TeardownDB(t, beaconServer.beaconDB)
+	slashings := make(chan *pbp2p.ProposerSlashing, 1)
+	sub := pool.proposerSlashingFeed.Subscribe(slashings)
+	defer sub.Unsubscribe()
+
+	slashing := signedProposerSlashing(t, privKeys, 1)
+	res, err := beaconServer.SubmitProposerSlashing(context.Background(), slashing)
+	if err != nil {
+		t.Fatalf("Could not submit proposer slashing: %v", err)
+	}
+	if pooled := <-slashings; !proto.Equal(pooled, slashing) {
+		t.Errorf("Expected slashing %v to be pooled, received %v", slashing, pooled)
+	}
+	if len(broadcaster.broadcasted) != 1 || !proto.Equal(broadcaster.broadcasted[0], slashing) {
+		t.Errorf("Expected the slashing to be broadcasted, received %v", broadcaster.broadcasted)
+	}
+	h, err := hashutil.HashProto(slashing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res.SlashingHash, h[:]) {
+		t.Errorf("Expected slashing hash %#x, received %#x", h, res.SlashingHash)
+	}
+
+	slashing = signedProposerSlashing(t, privKeys, 3)
+	want := "invalid proposer slashing: proposer index 3 is already slashed"
+	if _, err := beaconServer.SubmitProposerSlashing(context.Background(), slashing); err == nil || err.Error() != want {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	// The proposals are signed by validator 2 instead of the slashed proposer.
+	slashing = signedProposerSlashing(t, privKeys, 2)
+	slashing.ProposerIndex = 1
+	want = "invalid proposer slashing: proposer slashing proposal 1 signature did not verify"
+	if _, err := beaconServer.SubmitProposerSlashing(context.Background(), slashing); err == nil || err.Error() != want {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	if len(broadcaster.broadcasted) != 1 {
+		t.Error("Expected the invalid slashings not to be broadcasted")
+	}
+}
+
+func TestSubmitAttesterSlashing_PoolsAndBroadcasts(t *testing.T) {
+	beaconServer, pool, broadcaster, privKeys := setupOperationPoolTest(t)
+	defer internal.TeardownDB(t, beaconServer.beaconDB)
+	slashings := make(chan *pbp2p.AttesterSlashing, 1)
+	sub := pool.attesterSlashingFeed.Subscribe(slashings)
+	defer sub.Unsubscribe()
+
+	slashing := &pbp2p.AttesterSlashing{
+		SlashableAttestation_1: &pbp2p.SlashableAttestation{
+			Data:             &pbp2p.AttestationData{Slot: params.BeaconConfig().GenesisSlot, JustifiedEpoch: 5},
+			ValidatorIndices: []uint64{1, 2},
+			CustodyBitfield:  []byte{0x03},
+		},
+		SlashableAttestation_2: &pbp2p.SlashableAttestation{
+			Data:             &pbp2p.AttestationData{Slot: params.BeaconConfig().GenesisSlot, JustifiedEpoch: 4},
+			ValidatorIndices: []uint64{2, 3},
+			CustodyBitfield:  []byte{0x03},
+		},
+	}
+	signSlashableAttestation(t, privKeys, slashing.SlashableAttestation_1)
+	signSlashableAttestation(t, privKeys, slashing.SlashableAttestation_2)
+	if _, err := beaconServer.SubmitAttesterSlashing(context.Background(), slashing); err != nil {
+		t.Fatalf("Could not submit attester slashing: %v", err)
+	}
+	if pooled := <-slashings; !proto.Equal(pooled, slashing) {
+		t.Errorf("Expected slashing %v to be pooled, received %v", slashing, pooled)
+	}
+	if len(broadcaster.broadcasted) != 1 || !proto.Equal(broadcaster.broadcasted[0], slashing) {
+		t.Errorf("Expected the slashing to be broadcasted, received %v", broadcaster.broadcasted)
+	}
+
+	// Validator 3 is the only validator in both attestations, and it is already slashed.
+	slashing.SlashableAttestation_1.ValidatorIndices = []uint64{1, 3}
+	signSlashableAttestation(t, privKeys, slashing.SlashableAttestation_1)
+	want := "invalid attester slashing: expected a non-empty list of slashable indices"
+	if _, err := beaconServer.SubmitAttesterSlashing(context.Background(), slashing); err == nil || err.Error() != want {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	if len(broadcaster.broadcasted) != 1 {
+		t.Error("Expected the invalid slashing not to be broadcasted")
+	}
+}
+
+func TestSubmitVoluntaryExit_PoolsAndBroadcasts(t *testing.T) {
+	beaconServer, pool, broadcaster, privKeys := setupOperationPoolTest(t)
+	defer internal.TeardownDB(t, beaconServer.beaconDB)
+	exits := make(chan *pbp2p.VoluntaryExit, 1)
+	sub := pool.exitFeed.Subscribe(exits)
+	defer sub.Unsubscribe()
+
+	exit := signedExit(t, privKeys, 1, params.BeaconConfig().GenesisEpoch)
+	res, err := beaconServer.SubmitVoluntaryExit(context.Background(), exit)
+	if err != nil {
+		t.Fatalf("Could not submit exit: %v", err)
+	}
+	if pooled := <-exits; !proto.Equal(pooled, exit) {
+		t.Errorf("Expected exit %v to be pooled, received %v", exit, pooled)
+	}
+	if len(broadcaster.broadcasted) != 1 || !proto.Equal(broadcaster.broadcasted[0], exit) {
+		t.Errorf("Expected the exit to be broadcasted, received %v", broadcaster.broadcasted)
+	}
+	h, err := hashutil.HashProto(exit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res.ExitHash, h[:]) {
+		t.Errorf("Expected exit hash %#x, received %#x", h, res.ExitHash)
+	}
+
+	exit.ValidatorIndex = 4
+	want := "invalid exit: validator index 4 out of range"
+	if _, err := beaconServer.SubmitVoluntaryExit(context.Background(), exit); err == nil || err.Error() != want {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	// The exit of validator 2 is signed by validator 1.
+	exit = signedExit(t, privKeys, 1, params.BeaconConfig().GenesisEpoch)
+	exit.ValidatorIndex = 2
+	want = "invalid exit: voluntary exit signature did not verify"
+	if _, err := beaconServer.SubmitVoluntaryExit(context.Background(), exit); err == nil || err.Error() != want {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+	if len(broadcaster.broadcasted) != 1 {
+		t.Error("Expected the invalid exits not to be broadcasted")
+	}
+}
@@ -0,0 +1,264 @@
+package rpc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var rejectedCalls = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "rpc_rejected_calls_total",
+	Help: "The number of RPC calls rejected by the limits of the server, by method and reason",
+}, []string{"method", "reason"})
+
+// defaultMethodConcurrency caps the concurrent calls of the methods costly enough to let a
+// few clients exhaust the resources of the node, unless they are configured otherwise.
+var defaultMethodConcurrency = map[string]int{
+	// Each call runs a full state transition.
+	"ProposerService/ComputeStateRoot": 4,
+}
+
+// forwardedForKey is the metadata key under which the HTTP gateway forwards the address of
+// its clients, so that each of them is rate limited on its own.
+const forwardedForKey = "x-forwarded-for"
+
+// gatewaySecretKey is the metadata key under which the HTTP gateway sends the secret
+// authenticating it, as only the gateway may name the client a call is made for.
+const gatewaySecretKey = "x-gateway-secret"
+
+// bucketCleanupInterval is the period after which the rate limiter forgets the clients
+// which are no longer limited.
+const bucketCleanupInterval = time.Minute
+
+// tokenBucket holds the calls a client can still make at once.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// limiter enforces the per-client rate limits and the per-method concurrency caps of the
+// RPC server.
+type limiter struct {
+	rate          float64
+	burst         float64
+	gatewaySecret string
+	semaphores    map[string]chan struct{}
+	mutex         sync.Mutex
+	buckets       map[string]*tokenBucket
+	lastCleanup   time.Time
+	now           func() time.Time
+}
+
+// newLimiter creates a limiter allowing each client address rate calls per second with
+// bursts of burst calls, and at most the given number of concurrent calls per method. The
+// methods are named by their service and method names, e.g. ProposerService/ProposeBlock.
+// A rate of zero disables rate limiting. The calls carrying the gateway secret are attributed
+// to the client the gateway forwards them for. Forwarded addresses are ignored if the secret
+// is empty.
+func newLimiter(rate float64, burst int, methodConcurrency map[string]int, gatewaySecret string) *limiter {
+	if burst < 1 {
+		burst = 1
+	}
+	semaphores := make(map[string]chan struct{})
+	for method, limit := range defaultMethodConcurrency {
+		semaphores[method] = make(chan struct{}, limit)
+	}
+	for method, limit := range methodConcurrency {
+		if limit <= 0 {
+			delete(semaphores, method)
+			continue
+		}
+		semaphores[method] = make(chan struct{}, limit)
+	}
+	return &limiter{
+		rate:          rate,
+		burst:         float64(burst),
+		gatewaySecret: gatewaySecret,
+		semaphores:    semaphores,
+		buckets:       make(map[string]*tokenBucket),
+		lastCleanup:   time.Now(),
+		now:           time.Now,
+	}
+}
+
+// unaryInterceptor rejects the unary calls exceeding the limits.
+func (l *limiter) unaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	release, err := l.acquire(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return handler(ctx, req)
+}
+
+// streamInterceptor rejects the streams exceeding the limits. A stream counts against the
+// concurrency cap of its method until it ends.
+func (l *limiter) streamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	release, err := l.acquire(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return handler(srv, ss)
+}
+
+// acquire admits a call to the method, returning the function releasing its concurrency
+// slot once the call ends.
+func (l *limiter) acquire(ctx context.Context, fullMethod string) (func(), error) {
+	method := shortMethodName(fullMethod)
+	if !l.allow(l.clientAddress(ctx)) {
+		rejectedCalls.WithLabelValues(method, "rate_limit").Inc()
+		return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	}
+	semaphore, ok := l.semaphores[method]
+	if !ok {
+		return func() {}, nil
+	}
+	select {
+	case semaphore <- struct{}{}:
+		return func() { <-semaphore }, nil
+	default:
+		rejectedCalls.WithLabelValues(method, "concurrency").Inc()
+		return nil, status.Errorf(codes.ResourceExhausted, "too many concurrent calls to %s", method)
+	}
+}
+
+// allow takes a token from the bucket of the client, refilled at the configured rate.
+func (l *limiter) allow(client string) bool {
+	if l.rate <= 0 {
+		return true
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	now := l.now()
+	if now.Sub(l.lastCleanup) > bucketCleanupInterval {
+		l.cleanup(now)
+	}
+	bucket, ok := l.buckets[client]
+	if !ok {
+		bucket = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[client] = bucket
+	}
+	bucket.tokens += now.Sub(bucket.last).Seconds() * l.rate
+	if bucket.tokens > l.burst {
+		bucket.tokens = l.burst
+	}
+	bucket.last = now
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+// cleanup forgets the clients whose buckets are full again, as a new bucket is equivalent.
+func (l *limiter) cleanup(now time.Time) {
+	for client, bucket := range l.buckets {
+		if bucket.tokens+now.Sub(bucket.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, client)
+		}
+	}
+	l.lastCleanup = now
+}
+
+// clientAddress returns the IP address of the client of a call, so that a client cannot
+// evade its limits by opening new connections. The calls of the HTTP gateway, authenticated
+// by the gateway secret, are attributed to the HTTP client it forwards them for.
+func (l *limiter) clientAddress(ctx context.Context) string {
+	var host string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		var err error
+		if host, _, err = net.SplitHostPort(p.Addr.String()); err != nil {
+			host = p.Addr.String()
+		}
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || !l.isGateway(md) {
+		return host
+	}
+	if forwarded := md[forwardedForKey]; len(forwarded) > 0 {
+		return forwarded[len(forwarded)-1]
+	}
+	return host
+}
+
+// isGateway checks whether a call carries the secret of the HTTP gateway.
+func (l *limiter) isGateway(md metadata.MD) bool {
+	if l.gatewaySecret == "" {
+		return false
+	}
+	for _, secret := range md[gatewaySecretKey] {
+		if subtle.ConstantTimeCompare([]byte(secret), []byte(l.gatewaySecret)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// newGatewaySecret generates the secret authenticating the HTTP gateway for the lifetime of
+// the process. No call is attributed to a forwarded address if it cannot be generated.
+func newGatewaySecret() string {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		log.Errorf("Could not generate gateway secret: %v", err)
+		return ""
+	}
+	return hex.EncodeToString(secret)
+}
+
+// shortMethodName turns a full gRPC method name such as
+// /ethereum.beacon.rpc.v1.ProposerService/ProposeBlock into ProposerService/ProposeBlock.
+func shortMethodName(fullMethod string) string {
+	name := strings.TrimPrefix(fullMethod, "/")
+	slash := strings.Index(name, "/")
+	if slash < 0 {
+		return name
+	}
+	if dot := strings.LastIndex(name[:slash], "."); dot >= 0 {
+		name = name[dot+1:]
+	}
+	return name
+}
+
+// ParseMethodConcurrency parses concurrency caps given as Service/Method=limit, e.g.
+// ProposerService/ComputeStateRoot=4. A limit of zero lifts the default cap of a method.
+func ParseMethodConcurrency(values []string) (map[string]int, error) {
+	caps := make(map[string]int, len(values))
+	for _, value := range values {
+		parts := strings.Split(value, "=")
+		if len(parts) != 2 || !strings.Contains(parts[0], "/") {
+			return nil, fmt.Errorf("invalid method concurrency %q, expected Service/Method=limit", value)
+		}
+		limit, err := strconv.Atoi(parts[1])
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid concurrency limit in %q", value)
+		}
+		caps[parts[0]] = limit
+	}
+	return caps, nil
+}
@@ -0,0 +1,140 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func clientContext(ip string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000},
+	})
+}
+
+func TestLimiter_RateLimitsEachClientAddress(t *testing.T) {
+	l := newLimiter(1, 2, nil, "")
+	now := time.Now()
+	l.now = func() time.Time { return now }
+	info := &grpc.UnaryServerInfo{FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+
+	for i := 0; i < 2; i++ {
+		if _, err := l.unaryInterceptor(clientContext("10.0.0.1"), nil, info, handler); err != nil {
+			t.Fatalf("Expected call %d of the burst to be allowed, received %v", i, err)
+		}
+	}
+	if _, err := l.unaryInterceptor(clientContext("10.0.0.1"), nil, info, handler); err == nil {
+		t.Error("Expected the call after the burst to be rate limited")
+	}
+	if _, err := l.unaryInterceptor(clientContext("10.0.0.2"), nil, info, handler); err != nil {
+		t.Errorf("Expected the call of another client to be allowed, received %v", err)
+	}
+
+	now = now.Add(time.Second)
+	if _, err := l.unaryInterceptor(clientContext("10.0.0.1"), nil, info, handler); err != nil {
+		t.Errorf("Expected the call to be allowed once the bucket refilled, received %v", err)
+	}
+}
+
+func TestLimiter_RateLimitsGatewayClientsSeparately(t *testing.T) {
+	l := newLimiter(1, 1, nil, "secret")
+	now := time.Now()
+	l.now = func() time.Time { return now }
+	info := &grpc.UnaryServerInfo{FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	forwardedFor := func(ctx context.Context, secret string, client string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(gatewaySecretKey, secret, forwardedForKey, client))
+	}
+
+	for _, client := range []string{"10.0.0.1", "10.0.0.2"} {
+		if _, err := l.unaryInterceptor(forwardedFor(clientContext("127.0.0.1"), "secret", client), nil, info, handler); err != nil {
+			t.Errorf("Expected the first call of gateway client %s to be allowed, received %v", client, err)
+		}
+	}
+	if _, err := l.unaryInterceptor(forwardedFor(clientContext("127.0.0.1"), "secret", "10.0.0.1"), nil, info, handler); err == nil {
+		t.Error("Expected the second call of a gateway client to be rate limited")
+	}
+
+	// Only the gateway, which knows the secret, can name another client. Local clients are
+	// not trusted either.
+	for _, client := range []string{"10.0.0.3", "127.0.0.2"} {
+		if _, err := l.unaryInterceptor(forwardedFor(clientContext(client), "wrong", "10.0.0.4"), nil, info, handler); err != nil {
+			t.Fatalf("Expected the first call of %s to be allowed, received %v", client, err)
+		}
+		if _, err := l.unaryInterceptor(forwardedFor(clientContext(client), "wrong", "10.0.0.5"), nil, info, handler); err == nil {
+			t.Errorf("Expected %s not to evade its limit with forwarded addresses", client)
+		}
+	}
+
+	// No call is attributed to a forwarded address without a gateway secret.
+	l = newLimiter(1, 1, nil, "")
+	l.now = func() time.Time { return now }
+	if _, err := l.unaryInterceptor(forwardedFor(clientContext("127.0.0.1"), "", "10.0.0.6"), nil, info, handler); err != nil {
+		t.Fatalf("Expected the first call to be allowed, received %v", err)
+	}
+	if _, err := l.unaryInterceptor(forwardedFor(clientContext("127.0.0.1"), "", "10.0.0.7"), nil, info, handler); err == nil {
+		t.Error("Expected forwarded addresses to be ignored without a gateway secret")
+	}
+}
+
+func TestLimiter_CapsConcurrentCalls(t *testing.T) {
+	l := newLimiter(0, 0, map[string]int{"ProposerService/ProposeBlock": 1}, "")
+	info := &grpc.UnaryServerInfo{FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock"}
+	started := make(chan struct{})
+	done := make(chan struct{})
+	blocking := func(ctx context.Context, req interface{}) (interface{}, error) {
+		close(started)
+		<-done
+		return nil, nil
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+
+	errs := make(chan error)
+	go func() {
+		_, err := l.unaryInterceptor(clientContext("10.0.0.1"), nil, info, blocking)
+		errs <- err
+	}()
+	<-started
+	if _, err := l.unaryInterceptor(clientContext("10.0.0.2"), nil, info, handler); err == nil {
+		t.Error("Expected the concurrent call to be rejected")
+	}
+	close(done)
+	if err := <-errs; err != nil {
+		t.Fatalf("Unexpected error of the first call: %v", err)
+	}
+	if _, err := l.unaryInterceptor(clientContext("10.0.0.2"), nil, info, handler); err != nil {
+		t.Errorf("Expected the call to be allowed once the first one ended, received %v", err)
+	}
+}
+
+func TestLimiter_DefaultCapCanBeLifted(t *testing.T) {
+	l := newLimiter(0, 0, nil, "")
+	if cap(l.semaphores["ProposerService/ComputeStateRoot"]) != defaultMethodConcurrency["ProposerService/ComputeStateRoot"] {
+		t.Error("Expected ComputeStateRoot to be capped by default")
+	}
+	l = newLimiter(0, 0, map[string]int{"ProposerService/ComputeStateRoot": 0}, "")
+	if _, ok := l.semaphores["ProposerService/ComputeStateRoot"]; ok {
+		t.Error("Expected the cap of ComputeStateRoot to be lifted")
+	}
+}
+
+func TestParseMethodConcurrency(t *testing.T) {
+	caps, err := ParseMethodConcurrency([]string{"ProposerService/ComputeStateRoot=2", "BeaconChainService/ListBlocks=8"})
+	if err != nil {
+		t.Fatalf("Could not parse caps: %v", err)
+	}
+	if caps["ProposerService/ComputeStateRoot"] != 2 || caps["BeaconChainService/ListBlocks"] != 8 {
+		t.Errorf("Unexpected caps %v", caps)
+	}
+	for _, value := range []string{"ComputeStateRoot=2", "ProposerService/ComputeStateRoot", "ProposerService/ComputeStateRoot=-1"} {
+		if _, err := ParseMethodConcurrency([]string{value}); err == nil {
+			t.Errorf("Expected %q to be rejected", value)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type p2pService interface {
+	p2p.Broadcaster
+	ID() peer.ID
+	Addrs() []ma.Multiaddr
+	Peers() []p2p.PeerInfo
+}
+
+type syncService interface {
+	IsSynced() (bool, error)
+	InitialSyncProgress() (currentSlot uint64, highestObservedSlot uint64)
+}
+
+type serviceRegistry interface {
+	Statuses() map[reflect.Type]error
+}
+
+// NodeServer defines a server implementation of the gRPC Node service,
+// providing node operators with information about a running node.
+type NodeServer struct {
+	p2p         p2pService
+	syncService syncService
+	registry    serviceRegistry
+	// allowAdmin enables the methods which change the node, such as SetLogLevel.
+	allowAdmin bool
+}
+
+// Identity returns the libp2p peer ID of the node and the multiaddrs it listens to.
+func (ns *NodeServer) Identity(ctx context.Context, _ *ptypes.Empty) (*pb.IdentityResponse, error) {
+	addrs := ns.p2p.Addrs()
+	addresses := make([]string, len(addrs))
+	for i, addr := range addrs {
+		addresses[i] = addr.String()
+	}
+	return &pb.IdentityResponse{
+		PeerId:    ns.p2p.ID().Pretty(),
+		Addresses: addresses,
+	}, nil
+}
+
+// ListPeers returns the connections of the node to its peers.
+func (ns *NodeServer) ListPeers(ctx context.Context, _ *ptypes.Empty) (*pb.ListPeersResponse, error) {
+	peers := ns.p2p.Peers()
+	res := &pb.ListPeersResponse{Peers: make([]*pb.PeerConnection, len(peers))}
+	for i, info := range peers {
+		res.Peers[i] = &pb.PeerConnection{
+			PeerId:    info.ID.Pretty(),
+			Address:   info.Addr.String(),
+			Inbound:   info.Inbound,
+			LatencyMs: uint64(info.Latency / time.Millisecond),
+		}
+	}
+	return res, nil
+}
+
+// SyncStatus returns whether the node is synced with the network, along with the progress
+// of initial sync.
+func (ns *NodeServer) SyncStatus(ctx context.Context, _ *ptypes.Empty) (*pb.SyncStatusResponse, error) {
+	synced, err := ns.syncService.IsSynced()
+	if err != nil {
+		return nil, fmt.Errorf("could not get sync status: %v", err)
+	}
+	currentSlot, highestObservedSlot := ns.syncService.InitialSyncProgress()
+	return &pb.SyncStatusResponse{
+		Synced:              synced,
+		InitialSyncSlot:     currentSlot,
+		HighestObservedSlot: highestObservedSlot,
+	}, nil
+}
+
+// Version returns the version of the node build.
+func (ns *NodeServer) Version(ctx context.Context, _ *ptypes.Empty) (*pb.VersionResponse, error) {
+	return &pb.VersionResponse{Version: version.GetVersion()}, nil
+}
+
+// Health returns the status of each service of the node, sorted by service name.
+func (ns *NodeServer) Health(ctx context.Context, _ *ptypes.Empty) (*pb.HealthResponse, error) {
+	statuses := ns.registry.Statuses()
+	res := &pb.HealthResponse{Services: make([]*pb.ServiceHealth, 0, len(statuses))}
+	for kind, err := range statuses {
+		health := &pb.ServiceHealth{Name: kind.String(), Healthy: err == nil}
+		if err != nil {
+			health.Error = err.Error()
+		}
+		res.Services = append(res.Services, health)
+	}
+	sort.Slice(res.Services, func(i, j int) bool {
+		return res.Services[i].Name < res.Services[j].Name
+	})
+	return res, nil
+}
+
+// SetLogLevel changes the verbosity of the node logs. The change is lost when the node
+// restarts. It is only allowed if the admin RPC is enabled.
+func (ns *NodeServer) SetLogLevel(ctx context.Context, req *pb.SetLogLevelRequest) (*ptypes.Empty, error) {
+	if !ns.allowAdmin {
+		return nil, status.Error(codes.PermissionDenied, "changing the log level requires the admin RPC to be enabled")
+	}
+	level, err := logrus.ParseLevel(req.Level)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse log level: %v", err)
+	}
+	log.WithField("level", level).Info("Changing log level")
+	logrus.SetLevel(level)
+	return &ptypes.Empty{}, nil
+}
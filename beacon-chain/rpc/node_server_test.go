@@ -0,0 +1,175 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockP2P struct {
+	id          peer.ID
+	addrs       []ma.Multiaddr
+	peers       []p2p.PeerInfo
+	broadcasted []proto.Message
+}
+
+func (m *mockP2P) Broadcast(msg proto.Message) {
+	m.broadcasted = append(m.broadcasted, msg)
+}
+
+func (m *mockP2P) ID() peer.ID {
+	return m.id
+}
+
+func (m *mockP2P) Addrs() []ma.Multiaddr {
+	return m.addrs
+}
+
+func (m *mockP2P) Peers() []p2p.PeerInfo {
+	return m.peers
+}
+
+type mockSyncService struct {
+	synced              bool
+	currentSlot         uint64
+	highestObservedSlot uint64
+}
+
+func (m *mockSyncService) IsSynced() (bool, error) {
+	return m.synced, nil
+}
+
+func (m *mockSyncService) InitialSyncProgress() (uint64, uint64) {
+	return m.currentSlot, m.highestObservedSlot
+}
+
+type mockRegistry struct {
+	statuses map[reflect.Type]error
+}
+
+func (m *mockRegistry) Statuses() map[reflect.Type]error {
+	return m.statuses
+}
+
+func TestIdentity_ReturnsPeerIDAndAddresses(t *testing.T) {
+	addr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/12000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodeServer := &NodeServer{p2p: &mockP2P{id: peer.ID("node"), addrs: []ma.Multiaddr{addr}}}
+
+	res, err := nodeServer.Identity(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Could not get identity: %v", err)
+	}
+	wanted := &pb.IdentityResponse{
+		PeerId:    peer.ID("node").Pretty(),
+		Addresses: []string{"/ip4/127.0.0.1/tcp/12000"},
+	}
+	if !proto.Equal(res, wanted) {
+		t.Errorf("Wanted %v, received %v", wanted, res)
+	}
+}
+
+func TestListPeers_ReturnsConnections(t *testing.T) {
+	addr, err := ma.NewMultiaddr("/ip4/10.0.0.1/tcp/12000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodeServer := &NodeServer{p2p: &mockP2P{peers: []p2p.PeerInfo{
+		{ID: peer.ID("a"), Addr: addr, Inbound: true, Latency: 25 * time.Millisecond},
+		{ID: peer.ID("b"), Addr: addr},
+	}}}
+
+	res, err := nodeServer.ListPeers(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Could not list peers: %v", err)
+	}
+	wanted := &pb.ListPeersResponse{Peers: []*pb.PeerConnection{
+		{PeerId: peer.ID("a").Pretty(), Address: "/ip4/10.0.0.1/tcp/12000", Inbound: true, LatencyMs: 25},
+		{PeerId: peer.ID("b").Pretty(), Address: "/ip4/10.0.0.1/tcp/12000"},
+	}}
+	if !proto.Equal(res, wanted) {
+		t.Errorf("Wanted %v, received %v", wanted, res)
+	}
+}
+
+func TestSyncStatus_ReturnsInitialSyncProgress(t *testing.T) {
+	nodeServer := &NodeServer{syncService: &mockSyncService{
+		currentSlot:         10,
+		highestObservedSlot: 20,
+	}}
+
+	res, err := nodeServer.SyncStatus(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Could not get sync status: %v", err)
+	}
+	wanted := &pb.SyncStatusResponse{InitialSyncSlot: 10, HighestObservedSlot: 20}
+	if !proto.Equal(res, wanted) {
+		t.Errorf("Wanted %v, received %v", wanted, res)
+	}
+}
+
+func TestHealth_ReturnsSortedServiceStatuses(t *testing.T) {
+	nodeServer := &NodeServer{registry: &mockRegistry{statuses: map[reflect.Type]error{
+		reflect.TypeOf(&NodeServer{}): errors.New("not synced"),
+		reflect.TypeOf(&Service{}):    nil,
+	}}}
+
+	res, err := nodeServer.Health(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Could not get health: %v", err)
+	}
+	wanted := &pb.HealthResponse{Services: []*pb.ServiceHealth{
+		{Name: "*rpc.NodeServer", Error: "not synced"},
+		{Name: "*rpc.Service", Healthy: true},
+	}}
+	if !proto.Equal(res, wanted) {
+		t.Errorf("Wanted %v, received %v", wanted, res)
+	}
+}
+
+func TestSetLogLevel_ChangesVerbosity(t *testing.T) {
+	defer logrus.SetLevel(logrus.GetLevel())
+	nodeServer := &NodeServer{allowAdmin: true}
+
+	if _, err := nodeServer.SetLogLevel(context.Background(), &pb.SetLogLevelRequest{Level: "debug"}); err != nil {
+		t.Fatalf("Could not set log level: %v", err)
+	}
+	if logrus.GetLevel() != logrus.DebugLevel {
+		t.Errorf("Expected log level %v, received %v", logrus.DebugLevel, logrus.GetLevel())
+	}
+
+	if _, err := nodeServer.SetLogLevel(context.Background(), &pb.SetLogLevelRequest{Level: "loud"}); err == nil {
+		t.Error("Expected an invalid log level to be rejected")
+	}
+	if logrus.GetLevel() != logrus.DebugLevel {
+		t.Errorf("Expected log level to stay %v, received %v", logrus.DebugLevel, logrus.GetLevel())
+	}
+}
+
+func TestSetLogLevel_RequiresAdmin(t *testing.T) {
+	defer logrus.SetLevel(logrus.GetLevel())
+	logrus.SetLevel(logrus.InfoLevel)
+	nodeServer := &NodeServer{}
+
+	_, err := nodeServer.SetLogLevel(context.Background(), &pb.SetLogLevelRequest{Level: "debug"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected a permission denied error, received %v", err)
+	}
+	if logrus.GetLevel() != logrus.InfoLevel {
+		t.Errorf("Expected log level to stay %v, received %v", logrus.InfoLevel, logrus.GetLevel())
+	}
+}
@@ -3,7 +3,10 @@ package rpc
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
@@ -103,6 +106,125 @@ func (ps *ProposerServer) PendingAttestations(ctx context.Context, req *pb.Pendi
 	}, nil
 }
 
+// PendingVoluntaryExits retrieves the voluntary exits kept in the beacon node's operations pool which
+// are valid against the current state, so that proposers can include them in their blocks. Exits are
+// returned by ascending epoch, at most one per validator and up to MAX_VOLUNTARY_EXITS.
+func (ps *ProposerServer) PendingVoluntaryExits(ctx context.Context, _ *ptypes.Empty) (*pb.PendingVoluntaryExitsResponse, error) {
+	beaconState, err := ps.beaconDB.State(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve beacon state: %v", err)
+	}
+	exits, err := ps.operationService.PendingExits()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending exits from operations service: %v", err)
+	}
+	sort.Slice(exits, func(i, j int) bool {
+		if exits[i].Epoch != exits[j].Epoch {
+			return exits[i].Epoch < exits[j].Epoch
+		}
+		return exits[i].ValidatorIndex < exits[j].ValidatorIndex
+	})
+
+	// A block cannot exit the same validator twice, as the second exit would be
+	// invalid once the first one is processed.
+	exiting := make(map[uint64]bool)
+	validExits := make([]*pbp2p.VoluntaryExit, 0, len(exits))
+	for _, exit := range exits {
+		if uint64(len(validExits)) == params.BeaconConfig().MaxVoluntaryExits {
+			break
+		}
+		if exiting[exit.ValidatorIndex] {
+			continue
+		}
+		if err := blocks.VerifyExit(beaconState, exit); err != nil {
+			continue
+		}
+		exiting[exit.ValidatorIndex] = true
+		validExits = append(validExits, exit)
+	}
+	return &pb.PendingVoluntaryExitsResponse{PendingExits: validExits}, nil
+}
+
+// PendingProposerSlashings retrieves the proposer slashings kept in the beacon node's operations pool
+// which are valid against the current state, so that proposers can include them in their blocks.
+// Slashings are returned by ascending proposer index, at most one per proposer and up to
+// MAX_PROPOSER_SLASHINGS.
+func (ps *ProposerServer) PendingProposerSlashings(ctx context.Context, _ *ptypes.Empty) (*pb.PendingProposerSlashingsResponse, error) {
+	beaconState, err := ps.beaconDB.State(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve beacon state: %v", err)
+	}
+	slashings, err := ps.operationService.PendingProposerSlashings()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending proposer slashings from operations service: %v", err)
+	}
+	sort.SliceStable(slashings, func(i, j int) bool {
+		return slashings[i].ProposerIndex < slashings[j].ProposerIndex
+	})
+
+	slashed := make(map[uint64]bool)
+	validSlashings := make([]*pbp2p.ProposerSlashing, 0, len(slashings))
+	for _, slashing := range slashings {
+		if uint64(len(validSlashings)) == params.BeaconConfig().MaxProposerSlashings {
+			break
+		}
+		if slashed[slashing.ProposerIndex] {
+			continue
+		}
+		if err := blocks.VerifyProposerSlashing(beaconState, slashing); err != nil {
+			continue
+		}
+		slashed[slashing.ProposerIndex] = true
+		validSlashings = append(validSlashings, slashing)
+	}
+	return &pb.PendingProposerSlashingsResponse{PendingProposerSlashings: validSlashings}, nil
+}
+
+// PendingAttesterSlashings retrieves the attester slashings kept in the beacon node's operations pool
+// which are valid against the current state, so that proposers can include them in their blocks.
+// Every returned slashing slashes at least one validator which the previous ones do not, up to
+// MAX_ATTESTER_SLASHINGS.
+func (ps *ProposerServer) PendingAttesterSlashings(ctx context.Context, _ *ptypes.Empty) (*pb.PendingAttesterSlashingsResponse, error) {
+	beaconState, err := ps.beaconDB.State(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve beacon state: %v", err)
+	}
+	slashings, err := ps.operationService.PendingAttesterSlashings()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending attester slashings from operations service: %v", err)
+	}
+
+	// A block cannot include a slashing whose validators were all slashed by a previous
+	// slashing of the block, as it would be invalid once the previous one is processed.
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	slashed := make(map[uint64]bool)
+	validSlashings := make([]*pbp2p.AttesterSlashing, 0, len(slashings))
+	for _, slashing := range slashings {
+		if uint64(len(validSlashings)) == params.BeaconConfig().MaxAttesterSlashings {
+			break
+		}
+		if err := blocks.VerifyAttesterSlashing(beaconState, slashing); err != nil {
+			continue
+		}
+		var indices []uint64
+		for _, idx1 := range slashing.SlashableAttestation_1.ValidatorIndices {
+			for _, idx2 := range slashing.SlashableAttestation_2.ValidatorIndices {
+				if idx1 == idx2 && !slashed[idx1] && beaconState.ValidatorRegistry[idx1].SlashedEpoch > currentEpoch {
+					indices = append(indices, idx1)
+				}
+			}
+		}
+		if len(indices) == 0 {
+			continue
+		}
+		for _, idx := range indices {
+			slashed[idx] = true
+		}
+		validSlashings = append(validSlashings, slashing)
+	}
+	return &pb.PendingAttesterSlashingsResponse{PendingAttesterSlashings: validSlashings}, nil
+}
+
 // ComputeStateRoot computes the state root after a block has been processed through a state transition and
 // returns it to the validator client.
 func (ps *ProposerServer) ComputeStateRoot(ctx context.Context, req *pbp2p.BeaconBlock) (*pb.StateRootResponse, error) {
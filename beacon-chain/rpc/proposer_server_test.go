@@ -6,6 +6,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
@@ -222,3 +224,134 @@ func TestPendingAttestations_OK(t *testing.T) {
 		t.Error("Expected pending attestations list to be non-empty")
 	}
 }
+
+func TestPendingVoluntaryExits_FiltersInvalidExits(t *testing.T) {
+	beaconServer, _, _, privKeys := setupOperationPoolTest(t)
+	defer internal.TeardownDB(t, beaconServer.beaconDB)
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	beaconState, err := beaconServer.beaconDB.State(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState.Slot = helpers.StartSlot(genesisEpoch + 5)
+	beaconState.ValidatorRegistry[3].ExitEpoch = genesisEpoch
+	if err := beaconServer.beaconDB.SaveState(beaconState); err != nil {
+		t.Fatal(err)
+	}
+	// The exit of validator 2 is signed by validator 1.
+	wrongSignature := signedExit(t, privKeys, 1, genesisEpoch+1)
+	wrongSignature.ValidatorIndex = 2
+	proposerServer := &ProposerServer{
+		operationService: &mockOperationService{
+			pendingExits: []*pbp2p.VoluntaryExit{
+				// Not valid before a later epoch.
+				signedExit(t, privKeys, 2, genesisEpoch+10),
+				// Duplicate exit of the same validator.
+				signedExit(t, privKeys, 0, genesisEpoch+3),
+				signedExit(t, privKeys, 0, genesisEpoch+2),
+				signedExit(t, privKeys, 1, genesisEpoch+1),
+				// Validator which already exited.
+				signedExit(t, privKeys, 3, genesisEpoch),
+				wrongSignature,
+			},
+		},
+		beaconDB: beaconServer.beaconDB,
+	}
+
+	res, err := proposerServer.PendingVoluntaryExits(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Unexpected error fetching pending exits: %v", err)
+	}
+	wanted := &pb.PendingVoluntaryExitsResponse{PendingExits: []*pbp2p.VoluntaryExit{
+		signedExit(t, privKeys, 1, genesisEpoch+1),
+		signedExit(t, privKeys, 0, genesisEpoch+2),
+	}}
+	if !proto.Equal(res, wanted) {
+		t.Errorf("Wanted %v, received %v", wanted, res)
+	}
+}
+
+func TestPendingProposerSlashings_FiltersInvalidSlashings(t *testing.T) {
+	beaconServer, _, _, privKeys := setupOperationPoolTest(t)
+	defer internal.TeardownDB(t, beaconServer.beaconDB)
+
+	valid0 := signedProposerSlashing(t, privKeys, 0)
+	valid2 := signedProposerSlashing(t, privKeys, 2)
+	// The proposals are signed by validator 2 instead of the slashed proposer.
+	wrongSignature := signedProposerSlashing(t, privKeys, 2)
+	wrongSignature.ProposerIndex = 1
+	proposerServer := &ProposerServer{
+		operationService: &mockOperationService{
+			pendingProposerSlashings: []*pbp2p.ProposerSlashing{
+				valid2,
+				// Validator which is already slashed.
+				signedProposerSlashing(t, privKeys, 3),
+				valid0,
+				// Duplicate slashing of the same proposer.
+				signedProposerSlashing(t, privKeys, 0),
+				wrongSignature,
+			},
+		},
+		beaconDB: beaconServer.beaconDB,
+	}
+
+	res, err := proposerServer.PendingProposerSlashings(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Unexpected error fetching pending proposer slashings: %v", err)
+	}
+	wanted := &pb.PendingProposerSlashingsResponse{
+		PendingProposerSlashings: []*pbp2p.ProposerSlashing{valid0, valid2},
+	}
+	if !proto.Equal(res, wanted) {
+		t.Errorf("Wanted %v, received %v", wanted, res)
+	}
+}
+
+func TestPendingAttesterSlashings_FiltersInvalidSlashings(t *testing.T) {
+	beaconServer, _, _, privKeys := setupOperationPoolTest(t)
+	defer internal.TeardownDB(t, beaconServer.beaconDB)
+
+	attesterSlashing := func(indices1 []uint64, indices2 []uint64) *pbp2p.AttesterSlashing {
+		slashing := &pbp2p.AttesterSlashing{
+			SlashableAttestation_1: &pbp2p.SlashableAttestation{
+				Data:             &pbp2p.AttestationData{Slot: params.BeaconConfig().GenesisSlot, JustifiedEpoch: 5},
+				ValidatorIndices: indices1,
+				CustodyBitfield:  []byte{byte(1<<uint(len(indices1)) - 1)},
+			},
+			SlashableAttestation_2: &pbp2p.SlashableAttestation{
+				Data:             &pbp2p.AttestationData{Slot: params.BeaconConfig().GenesisSlot, JustifiedEpoch: 4},
+				ValidatorIndices: indices2,
+				CustodyBitfield:  []byte{byte(1<<uint(len(indices2)) - 1)},
+			},
+		}
+		signSlashableAttestation(t, privKeys, slashing.SlashableAttestation_1)
+		signSlashableAttestation(t, privKeys, slashing.SlashableAttestation_2)
+		return slashing
+	}
+	slashes2 := attesterSlashing([]uint64{1, 2}, []uint64{2, 3})
+	slashes1 := attesterSlashing([]uint64{0, 1}, []uint64{1})
+	proposerServer := &ProposerServer{
+		operationService: &mockOperationService{
+			pendingAttesterSlashings: []*pbp2p.AttesterSlashing{
+				slashes2,
+				// Validator 2 is already slashed by the previous slashing.
+				attesterSlashing([]uint64{2}, []uint64{2}),
+				// Validator 3 is already slashed.
+				attesterSlashing([]uint64{3}, []uint64{3}),
+				slashes1,
+			},
+		},
+		beaconDB: beaconServer.beaconDB,
+	}
+
+	res, err := proposerServer.PendingAttesterSlashings(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Unexpected error fetching pending attester slashings: %v", err)
+	}
+	wanted := &pb.PendingAttesterSlashingsResponse{
+		PendingAttesterSlashings: []*pbp2p.AttesterSlashing{slashes2, slashes1},
+	}
+	if !proto.Equal(res, wanted) {
+		t.Errorf("Wanted %v, received %v", wanted, res)
+	}
+}
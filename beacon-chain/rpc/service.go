@@ -9,11 +9,13 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/stategenerator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/tlsutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
@@ -23,6 +25,10 @@ import (
 
 var log logrus.FieldLogger
 
+// stateGeneratorCacheSize is the number of states regenerated for the RPC clients which are
+// kept in memory.
+const stateGeneratorCacheSize = 8
+
 func init() {
 	log = logrus.WithField("prefix", "rpc")
 }
@@ -34,13 +40,20 @@ type chainService interface {
 	// time the canonical head changes in the chain service.
 	CanonicalBlockFeed() *event.Feed
 	CanonicalStateFeed() *event.Feed
+	ReorgFeed() *event.Feed
+	CheckpointFeed() *event.Feed
 	StateInitializedFeed() *event.Feed
 }
 
 type operationService interface {
 	IncomingExitFeed() *event.Feed
 	IncomingAttFeed() *event.Feed
+	IncomingProposerSlashingFeed() *event.Feed
+	IncomingAttesterSlashingFeed() *event.Feed
 	PendingAttestations() ([]*pbp2p.Attestation, error)
+	PendingExits() ([]*pbp2p.VoluntaryExit, error)
+	PendingProposerSlashings() ([]*pbp2p.ProposerSlashing, error)
+	PendingAttesterSlashings() ([]*pbp2p.AttesterSlashing, error)
 }
 
 type powChainService interface {
@@ -60,17 +73,24 @@ type Service struct {
 	chainService          chainService
 	powChainService       powChainService
 	operationService      operationService
+	p2p                   p2pService
+	syncService           syncService
+	registry              serviceRegistry
 	port                  string
 	chainStartDelayFlag   uint64
 	listener              net.Listener
 	withCert              string
 	withKey               string
+	withClientCA          string
 	grpcServer            *grpc.Server
 	canonicalBlockChan    chan *pbp2p.BeaconBlock
 	canonicalStateChan    chan *pbp2p.BeaconState
 	incomingAttestation   chan *pbp2p.Attestation
 	slotAlignmentDuration time.Duration
 	credentialError       error
+	limiter               *limiter
+	maxMsgSize            int
+	enableAdminRPC        bool
 }
 
 // Config options for the beacon node RPC server.
@@ -78,12 +98,22 @@ type Config struct {
 	Port                string
 	CertFlag            string
 	KeyFlag             string
+	ClientCAFlag        string
 	ChainStartDelayFlag uint64
 	SubscriptionBuf     int
 	BeaconDB            *db.BeaconDB
 	ChainService        chainService
 	POWChainService     powChainService
 	OperationService    operationService
+	P2P                 p2pService
+	SyncService         syncService
+	ServiceRegistry     serviceRegistry
+	RateLimit           float64
+	RateBurst           int
+	MethodConcurrency   map[string]int
+	MaxMsgSize          int
+	// EnableAdminRPC serves the admin service and the node methods which change the node.
+	EnableAdminRPC bool
 }
 
 // NewRPCService creates a new instance of a struct implementing the BeaconServiceServer
@@ -97,17 +127,30 @@ func NewRPCService(ctx context.Context, cfg *Config) *Service {
 		chainService:          cfg.ChainService,
 		powChainService:       cfg.POWChainService,
 		operationService:      cfg.OperationService,
+		p2p:                   cfg.P2P,
+		syncService:           cfg.SyncService,
+		registry:              cfg.ServiceRegistry,
 		port:                  cfg.Port,
 		withCert:              cfg.CertFlag,
 		withKey:               cfg.KeyFlag,
+		withClientCA:          cfg.ClientCAFlag,
 		chainStartDelayFlag:   cfg.ChainStartDelayFlag,
 		slotAlignmentDuration: time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second,
 		canonicalBlockChan:    make(chan *pbp2p.BeaconBlock, cfg.SubscriptionBuf),
 		canonicalStateChan:    make(chan *pbp2p.BeaconState, cfg.SubscriptionBuf),
 		incomingAttestation:   make(chan *pbp2p.Attestation, cfg.SubscriptionBuf),
+		limiter:               newLimiter(cfg.RateLimit, cfg.RateBurst, cfg.MethodConcurrency, newGatewaySecret()),
+		maxMsgSize:            cfg.MaxMsgSize,
+		enableAdminRPC:        cfg.EnableAdminRPC,
 	}
 }
 
+// GatewaySecret returns the secret the HTTP gateway of the node authenticates its calls
+// with, so that they are rate limited by the HTTP client they are made for.
+func (s *Service) GatewaySecret() string {
+	return s.limiter.gatewaySecret
+}
+
 // Start the gRPC server.
 func (s *Service) Start() {
 	log.Info("Starting service")
@@ -118,18 +161,36 @@ func (s *Service) Start() {
 	s.listener = lis
 	log.Infof("RPC server listening on port :%s", s.port)
 
-	// TODO(#791): Utilize a certificate for secure connections
-	// between beacon nodes and validator clients.
+	// Clients are rate limited by address, and the costly methods by concurrent calls.
+	opts := []grpc.ServerOption{
+		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
+		grpc.UnaryInterceptor(s.limiter.unaryInterceptor),
+		grpc.StreamInterceptor(s.limiter.streamInterceptor),
+	}
+	if s.maxMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(s.maxMsgSize))
+	}
+
+	// The certificates are reloaded on SIGHUP or when their files change. Only the clients
+	// with a certificate issued by one of the client CAs can connect if they are set.
 	if s.withCert != "" && s.withKey != "" {
-		creds, err := credentials.NewServerTLSFromFile(s.withCert, s.withKey)
+		reloader, err := tlsutil.NewReloader(s.withCert, s.withKey, s.withClientCA)
 		if err != nil {
 			log.Errorf("Could not load TLS keys: %s", err)
 			s.credentialError = err
+			// Never fall back to serving the API without TLS.
+			s.closeListener()
+			return
+		}
+		go reloader.Watch(s.ctx)
+		if s.withClientCA == "" {
+			log.Warn("Any client can connect to the gRPC server! Provide a client CA bundle to only accept authorized clients")
 		}
-		s.grpcServer = grpc.NewServer(grpc.Creds(creds), grpc.StatsHandler(&ocgrpc.ServerHandler{}))
+		creds := credentials.NewTLS(reloader.ServerConfig())
+		s.grpcServer = grpc.NewServer(append(opts, grpc.Creds(creds))...)
 	} else {
 		log.Warn("You are using an insecure gRPC connection! Provide a certificate and key to connect securely")
-		s.grpcServer = grpc.NewServer(grpc.StatsHandler(&ocgrpc.ServerHandler{}))
+		s.grpcServer = grpc.NewServer(opts...)
 	}
 
 	beaconServer := &BeaconServer{
@@ -138,6 +199,7 @@ func (s *Service) Start() {
 		powChainService:     s.powChainService,
 		chainService:        s.chainService,
 		operationService:    s.operationService,
+		p2p:                 s.p2p,
 		incomingAttestation: s.incomingAttestation,
 		canonicalStateChan:  s.canonicalStateChan,
 		chainStartDelayFlag: s.chainStartDelayFlag,
@@ -160,10 +222,37 @@ func (s *Service) Start() {
 		chainService:       s.chainService,
 		canonicalStateChan: s.canonicalStateChan,
 	}
+	stateGenerator, err := stategenerator.NewStateGenerator(s.beaconDB, stateGeneratorCacheSize)
+	if err != nil {
+		log.Errorf("Could not create state generator, past states will not be served: %v", err)
+	}
+	beaconChainServer := &BeaconChainServer{
+		ctx:            s.ctx,
+		beaconDB:       s.beaconDB,
+		chainService:   s.chainService,
+		stateGenerator: stateGenerator,
+	}
+	nodeServer := &NodeServer{
+		p2p:         s.p2p,
+		syncService: s.syncService,
+		registry:    s.registry,
+		allowAdmin:  s.enableAdminRPC,
+	}
 	pb.RegisterBeaconServiceServer(s.grpcServer, beaconServer)
 	pb.RegisterProposerServiceServer(s.grpcServer, proposerServer)
 	pb.RegisterAttesterServiceServer(s.grpcServer, attesterServer)
 	pb.RegisterValidatorServiceServer(s.grpcServer, validatorServer)
+	pb.RegisterBeaconChainServiceServer(s.grpcServer, beaconChainServer)
+	pb.RegisterNodeServiceServer(s.grpcServer, nodeServer)
+	// The admin service hands out the whole DB, so it is only served when explicitly enabled,
+	// like the methods of the node service which change the node.
+	if s.enableAdminRPC {
+		log.Warn("Serving the admin RPC service, only expose the RPC port to node operators")
+		adminServer := &AdminServer{
+			beaconDB: s.beaconDB,
+		}
+		pb.RegisterAdminServiceServer(s.grpcServer, adminServer)
+	}
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
@@ -188,6 +277,16 @@ func (s *Service) Stop() error {
 	return nil
 }
 
+func (s *Service) closeListener() {
+	if s.listener == nil {
+		return
+	}
+	if err := s.listener.Close(); err != nil {
+		log.Errorf("Could not close listener: %v", err)
+	}
+	s.listener = nil
+}
+
 // Status returns nil or credentialError
 func (s *Service) Status() error {
 	if s.credentialError != nil {
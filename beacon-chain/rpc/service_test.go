@@ -30,7 +30,10 @@ func (t *TestLogger) Errorf(format string, args ...interface{}) {
 }
 
 type mockOperationService struct {
-	pendingAttestations []*pb.Attestation
+	pendingAttestations      []*pb.Attestation
+	pendingExits             []*pb.VoluntaryExit
+	pendingProposerSlashings []*pb.ProposerSlashing
+	pendingAttesterSlashings []*pb.AttesterSlashing
 }
 
 func (ms *mockOperationService) IncomingAttFeed() *event.Feed {
@@ -41,6 +44,26 @@ func (ms *mockOperationService) IncomingExitFeed() *event.Feed {
 	return new(event.Feed)
 }
 
+func (ms *mockOperationService) IncomingProposerSlashingFeed() *event.Feed {
+	return new(event.Feed)
+}
+
+func (ms *mockOperationService) IncomingAttesterSlashingFeed() *event.Feed {
+	return new(event.Feed)
+}
+
+func (ms *mockOperationService) PendingExits() ([]*pb.VoluntaryExit, error) {
+	return ms.pendingExits, nil
+}
+
+func (ms *mockOperationService) PendingProposerSlashings() ([]*pb.ProposerSlashing, error) {
+	return ms.pendingProposerSlashings, nil
+}
+
+func (ms *mockOperationService) PendingAttesterSlashings() ([]*pb.AttesterSlashing, error) {
+	return ms.pendingAttesterSlashings, nil
+}
+
 func (ms *mockOperationService) PendingAttestations() ([]*pb.Attestation, error) {
 	if ms.pendingAttestations != nil {
 		return ms.pendingAttestations, nil
@@ -70,6 +93,8 @@ func (ms *mockOperationService) PendingAttestations() ([]*pb.Attestation, error)
 type mockChainService struct {
 	blockFeed            *event.Feed
 	stateFeed            *event.Feed
+	reorgFeed            *event.Feed
+	checkpointFeed       *event.Feed
 	attestationFeed      *event.Feed
 	stateInitializedFeed *event.Feed
 }
@@ -86,6 +111,14 @@ func (m *mockChainService) CanonicalStateFeed() *event.Feed {
 	return m.stateFeed
 }
 
+func (m *mockChainService) ReorgFeed() *event.Feed {
+	return m.reorgFeed
+}
+
+func (m *mockChainService) CheckpointFeed() *event.Feed {
+	return m.checkpointFeed
+}
+
 func (m *mockChainService) StateInitializedFeed() *event.Feed {
 	return m.stateInitializedFeed
 }
@@ -94,6 +127,8 @@ func newMockChainService() *mockChainService {
 	return &mockChainService{
 		blockFeed:            new(event.Feed),
 		stateFeed:            new(event.Feed),
+		reorgFeed:            new(event.Feed),
+		checkpointFeed:       new(event.Feed),
 		attestationFeed:      new(event.Feed),
 		stateInitializedFeed: new(event.Feed),
 	}
@@ -154,6 +189,25 @@ func TestStatus_CredentialError(t *testing.T) {
 	}
 }
 
+func TestRPC_InvalidCredentialsNotServed(t *testing.T) {
+	rpcService := NewRPCService(context.Background(), &Config{
+		Port:         "7349",
+		CertFlag:     "alice.crt",
+		KeyFlag:      "alice.key",
+		ClientCAFlag: "ca.crt",
+	})
+
+	rpcService.Start()
+	defer rpcService.Stop()
+
+	if rpcService.Status() == nil {
+		t.Error("Expected a credential error")
+	}
+	if rpcService.listener != nil {
+		t.Error("Expected the RPC server not to listen without valid credentials")
+	}
+}
+
 func TestRPC_InsecureEndpoint(t *testing.T) {
 	hook := logTest.NewGlobal()
 	rpcService := NewRPCService(context.Background(), &Config{
@@ -169,3 +223,25 @@ func TestRPC_InsecureEndpoint(t *testing.T) {
 	rpcService.Stop()
 	testutil.AssertLogsContain(t, hook, "Stopping service")
 }
+
+func TestRPC_AdminServiceRequiresFlag(t *testing.T) {
+	tests := []struct {
+		port           string
+		enableAdminRPC bool
+	}{
+		{port: "7350", enableAdminRPC: false},
+		{port: "7351", enableAdminRPC: true},
+	}
+	for _, tt := range tests {
+		rpcService := NewRPCService(context.Background(), &Config{
+			Port:           tt.port,
+			EnableAdminRPC: tt.enableAdminRPC,
+		})
+		rpcService.Start()
+		_, served := rpcService.grpcServer.GetServiceInfo()["ethereum.beacon.rpc.v1.AdminService"]
+		rpcService.Stop()
+		if served != tt.enableAdminRPC {
+			t.Errorf("Expected admin service served to be %v, received %v", tt.enableAdminRPC, served)
+		}
+	}
+}
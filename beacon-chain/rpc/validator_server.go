@@ -28,11 +28,11 @@ type ValidatorServer struct {
 // beacon state, if not, then it creates a stream which listens for canonical states which contain
 // the validator with the public key as an active validator record.
 func (vs *ValidatorServer) WaitForActivation(req *pb.ValidatorActivationRequest, stream pb.ValidatorService_WaitForActivationServer) error {
-	if vs.beaconDB.HasValidator(req.Pubkey) {
-		beaconState, err := vs.beaconDB.State(vs.ctx)
-		if err != nil {
-			return fmt.Errorf("could not retrieve beacon state: %v", err)
-		}
+	beaconState, err := vs.beaconDB.State(vs.ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve beacon state: %v", err)
+	}
+	if vs.isActiveValidator(beaconState, req.Pubkey) {
 		activeVal, err := vs.retrieveActiveValidator(beaconState, req.Pubkey)
 		if err != nil {
 			return fmt.Errorf("could not retrieve active validator from state: %v", err)
@@ -48,7 +48,7 @@ func (vs *ValidatorServer) WaitForActivation(req *pb.ValidatorActivationRequest,
 		select {
 		case <-time.After(3 * time.Second):
 		case beaconState := <-vs.canonicalStateChan:
-			if !vs.beaconDB.HasValidator(req.Pubkey) {
+			if !vs.isActiveValidator(beaconState, req.Pubkey) {
 				continue
 			}
 			activeVal, err := vs.retrieveActiveValidator(beaconState, req.Pubkey)
@@ -140,30 +140,29 @@ func (vs *ValidatorServer) ValidatorStatus(
 		return nil, fmt.Errorf("could not get active validator index: %v", err)
 	}
 
-	var status pb.ValidatorStatus
-	v := beaconState.ValidatorRegistry[idx]
+	return &pb.ValidatorStatusResponse{
+		Status: validatorStatus(beaconState.ValidatorRegistry[idx], helpers.CurrentEpoch(beaconState)),
+	}, nil
+}
+
+// validatorStatus returns the status of a validator at the given epoch.
+func validatorStatus(v *pbp2p.Validator, epoch uint64) pb.ValidatorStatus {
 	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
-	epoch := helpers.CurrentEpoch(beaconState)
 
 	if v.ActivationEpoch == farFutureEpoch {
-		status = pb.ValidatorStatus_PENDING_ACTIVE
+		return pb.ValidatorStatus_PENDING_ACTIVE
 	} else if v.ActivationEpoch <= epoch && epoch < v.ExitEpoch {
-		status = pb.ValidatorStatus_ACTIVE
+		return pb.ValidatorStatus_ACTIVE
 	} else if v.StatusFlags == pbp2p.Validator_INITIATED_EXIT {
-		status = pb.ValidatorStatus_INITIATED_EXIT
+		return pb.ValidatorStatus_INITIATED_EXIT
 	} else if v.StatusFlags == pbp2p.Validator_WITHDRAWABLE {
-		status = pb.ValidatorStatus_WITHDRAWABLE
+		return pb.ValidatorStatus_WITHDRAWABLE
 	} else if epoch >= v.ExitEpoch && epoch >= v.SlashedEpoch {
-		status = pb.ValidatorStatus_EXITED_SLASHED
+		return pb.ValidatorStatus_EXITED_SLASHED
 	} else if epoch >= v.ExitEpoch {
-		status = pb.ValidatorStatus_EXITED
-	} else {
-		status = pb.ValidatorStatus_UNKNOWN_STATUS
+		return pb.ValidatorStatus_EXITED
 	}
-
-	return &pb.ValidatorStatusResponse{
-		Status: status,
-	}, nil
+	return pb.ValidatorStatus_UNKNOWN_STATUS
 }
 
 func (vs *ValidatorServer) retrieveActiveValidator(beaconState *pbp2p.BeaconState, pubkey []byte) (*pbp2p.Validator, error) {
@@ -171,5 +170,19 @@ func (vs *ValidatorServer) retrieveActiveValidator(beaconState *pbp2p.BeaconStat
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve validator index: %v", err)
 	}
+	if validatorIdx >= uint64(len(beaconState.GetValidatorRegistry())) {
+		return nil, fmt.Errorf("validator index %d is not in the registry", validatorIdx)
+	}
 	return beaconState.ValidatorRegistry[validatorIdx], nil
 }
+
+// isActiveValidator checks if the validator with the given public key is active at the
+// current epoch of the given state. The validator index also holds validators which are
+// not activated yet, so being indexed is not enough.
+func (vs *ValidatorServer) isActiveValidator(beaconState *pbp2p.BeaconState, pubkey []byte) bool {
+	validator, err := vs.retrieveActiveValidator(beaconState, pubkey)
+	if err != nil {
+		return false
+	}
+	return helpers.IsActiveValidator(validator, helpers.CurrentEpoch(beaconState))
+}
@@ -486,7 +486,7 @@ func TestWaitForActivation_ValidatorOriginallyExists(t *testing.T) {
 	beaconState := &pbp2p.BeaconState{
 		Slot: params.BeaconConfig().GenesisSlot,
 		ValidatorRegistry: []*pbp2p.Validator{{
-			ActivationEpoch: params.BeaconConfig().GenesisSlot,
+			ActivationEpoch: params.BeaconConfig().GenesisEpoch,
 			ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
 			Pubkey:          pubKey},
 		},
@@ -524,18 +524,30 @@ func TestWaitForActivation_ListensAndFetchesValidatorFromStateFeed(t *testing.T)
 	defer internal.TeardownDB(t, db)
 
 	pubKey := []byte{'A'}
+	if err := db.SaveValidatorIndex(pubKey, 0); err != nil {
+		t.Fatalf("Could not save validator index: %v", err)
+	}
 
-	beaconState := &pbp2p.BeaconState{
+	// The validator is in the registry, but waits for its activation.
+	pendingState := &pbp2p.BeaconState{
 		Slot: params.BeaconConfig().GenesisSlot,
 		ValidatorRegistry: []*pbp2p.Validator{{
-			ActivationEpoch: params.BeaconConfig().GenesisSlot,
+			ActivationEpoch: params.BeaconConfig().FarFutureEpoch,
 			ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
 			Pubkey:          pubKey},
 		},
 	}
-	if err := db.SaveState(beaconState); err != nil {
+	if err := db.SaveState(pendingState); err != nil {
 		t.Fatalf("could not save state: %v", err)
 	}
+	activeState := &pbp2p.BeaconState{
+		Slot: params.BeaconConfig().GenesisSlot + params.BeaconConfig().SlotsPerEpoch,
+		ValidatorRegistry: []*pbp2p.Validator{{
+			ActivationEpoch: params.BeaconConfig().GenesisEpoch + 1,
+			ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
+			Pubkey:          pubKey},
+		},
+	}
 
 	vs := &ValidatorServer{
 		beaconDB:           db,
@@ -552,21 +564,18 @@ func TestWaitForActivation_ListensAndFetchesValidatorFromStateFeed(t *testing.T)
 	mockStream := internal.NewMockValidatorService_WaitForActivationServer(ctrl)
 	mockStream.EXPECT().Send(
 		&pb.ValidatorActivationResponse{
-			Validator: beaconState.ValidatorRegistry[0],
+			Validator: activeState.ValidatorRegistry[0],
 		},
 	).Return(nil)
 
 	exitRoutine := make(chan bool)
 	go func(tt *testing.T) {
 		if err := vs.WaitForActivation(req, mockStream); err != nil {
-			t.Fatalf("Could not setup wait for activation stream: %v", err)
+			tt.Errorf("Could not setup wait for activation stream: %v", err)
 		}
 		<-exitRoutine
 	}(t)
-	vs.canonicalStateChan <- beaconState
-	if err := db.SaveValidatorIndex(pubKey, 0); err != nil {
-		t.Fatalf("Could not save validator index: %v", err)
-	}
-	vs.canonicalStateChan <- beaconState
+	vs.canonicalStateChan <- pendingState
+	vs.canonicalStateChan <- activeState
 	exitRoutine <- true
 }
@@ -15,6 +15,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -141,7 +142,7 @@ func (s *InitialSync) Start() {
 	if err != nil {
 		log.Errorf("Unable to get chain head %v", err)
 	}
-	s.currentSlot = cHead.Slot
+	atomic.StoreUint64(&s.currentSlot, cHead.Slot)
 
 	var reqState bool
 	// setting genesis bool
@@ -167,7 +168,17 @@ func (s *InitialSync) Stop() error {
 
 // InitializeObservedSlot sets the highest observed slot.
 func (s *InitialSync) InitializeObservedSlot(slot uint64) {
-	s.highestObservedSlot = slot
+	atomic.StoreUint64(&s.highestObservedSlot, slot)
+}
+
+// CurrentSlot returns the slot of the latest block processed by initial sync.
+func (s *InitialSync) CurrentSlot() uint64 {
+	return atomic.LoadUint64(&s.currentSlot)
+}
+
+// HighestObservedSlot returns the highest slot of the blocks announced by the peers.
+func (s *InitialSync) HighestObservedSlot() uint64 {
+	return atomic.LoadUint64(&s.highestObservedSlot)
 }
 
 // InitializeStateRoot sets the state root of the highest observed slot.
@@ -205,7 +216,7 @@ func (s *InitialSync) run(delayChan <-chan time.Time) {
 		}
 	} else {
 		// Send out a batch request
-		s.requestBatchedBlocks(s.currentSlot+1, s.highestObservedSlot)
+		s.requestBatchedBlocks(s.CurrentSlot()+1, s.HighestObservedSlot())
 	}
 
 	for {
@@ -239,11 +250,11 @@ func (s *InitialSync) checkInMemoryBlocks() {
 		case <-s.ctx.Done():
 			return
 		default:
-			if s.currentSlot == s.highestObservedSlot {
+			if s.CurrentSlot() == s.HighestObservedSlot() {
 				return
 			}
 			s.mutex.Lock()
-			if block, ok := s.inMemoryBlocks[s.currentSlot+1]; ok && s.currentSlot+1 <= s.highestObservedSlot {
+			if block, ok := s.inMemoryBlocks[s.CurrentSlot()+1]; ok && s.CurrentSlot()+1 <= s.HighestObservedSlot() {
 				s.processBlock(s.ctx, block, p2p.Peer{})
 			}
 			s.mutex.Unlock()
@@ -260,14 +271,14 @@ func (s *InitialSync) checkSyncStatus() bool {
 		}
 		return false
 	}
-	if s.highestObservedSlot == s.currentSlot {
+	if s.HighestObservedSlot() == s.CurrentSlot() {
 		log.Info("Exiting initial sync and starting normal sync")
-		s.syncedFeed.Send(s.currentSlot)
+		s.syncedFeed.Send(s.CurrentSlot())
 		s.syncService.ResumeSync()
 		return true
 	}
 	// requests multiple blocks so as to save and sync quickly.
-	s.requestBatchedBlocks(s.currentSlot+1, s.highestObservedSlot)
+	s.requestBatchedBlocks(s.CurrentSlot()+1, s.HighestObservedSlot())
 	return false
 }
 
@@ -284,11 +295,11 @@ func (s *InitialSync) processBlockAnnounce(msg p2p.Message) {
 		return
 	}
 
-	if data.SlotNumber > s.highestObservedSlot {
-		s.highestObservedSlot = data.SlotNumber
+	if data.SlotNumber > s.HighestObservedSlot() {
+		atomic.StoreUint64(&s.highestObservedSlot, data.SlotNumber)
 	}
 
-	s.requestBatchedBlocks(s.currentSlot+1, s.highestObservedSlot)
+	s.requestBatchedBlocks(s.CurrentSlot()+1, s.HighestObservedSlot())
 	log.Debugf("Successfully requested the next block with slot: %d", data.SlotNumber)
 }
 
@@ -299,12 +310,12 @@ func (s *InitialSync) processBlock(ctx context.Context, block *pb.BeaconBlock, p
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.sync.initial-sync.processBlock")
 	defer span.End()
 	recBlock.Inc()
-	if block.Slot > s.highestObservedSlot {
-		s.highestObservedSlot = block.Slot
+	if block.Slot > s.HighestObservedSlot() {
+		atomic.StoreUint64(&s.highestObservedSlot, block.Slot)
 		s.stateRootOfHighestObservedSlot = bytesutil.ToBytes32(block.StateRootHash32)
 	}
 
-	if block.Slot < s.currentSlot {
+	if block.Slot < s.CurrentSlot() {
 		return
 	}
 
@@ -316,7 +327,7 @@ func (s *InitialSync) processBlock(ctx context.Context, block *pb.BeaconBlock, p
 		return
 	}
 	// if it isn't the block in the next slot it saves it in memory.
-	if block.Slot != (s.currentSlot + 1) {
+	if block.Slot != (s.CurrentSlot() + 1) {
 		s.mutex.Lock()
 		defer s.mutex.Unlock()
 		if _, ok := s.inMemoryBlocks[block.Slot]; !ok {
@@ -354,7 +365,7 @@ func (s *InitialSync) processState(msg p2p.Message) {
 	beaconState := data.BeaconState
 	recState.Inc()
 
-	if s.currentSlot > beaconState.FinalizedEpoch*params.BeaconConfig().SlotsPerEpoch {
+	if s.CurrentSlot() > beaconState.FinalizedEpoch*params.BeaconConfig().SlotsPerEpoch {
 		return
 	}
 
@@ -374,11 +385,11 @@ func (s *InitialSync) processState(msg p2p.Message) {
 
 	// sets the current slot to the last finalized slot of the
 	// beacon state to begin our sync from.
-	s.currentSlot = beaconState.FinalizedEpoch * params.BeaconConfig().SlotsPerEpoch
+	atomic.StoreUint64(&s.currentSlot, beaconState.FinalizedEpoch*params.BeaconConfig().SlotsPerEpoch)
 	s.beaconStateSlot = beaconState.Slot
 	log.Debugf("Successfully saved beacon state with the last finalized slot: %d", beaconState.FinalizedEpoch*params.BeaconConfig().SlotsPerEpoch)
 
-	s.requestBatchedBlocks(s.currentSlot+1, s.highestObservedSlot)
+	s.requestBatchedBlocks(s.CurrentSlot()+1, s.HighestObservedSlot())
 }
 
 // requestStateFromPeer sends a request to a peer for the corresponding state
@@ -434,14 +445,14 @@ func (s *InitialSync) validateAndSaveNextBlock(ctx context.Context, block *pb.Be
 		return err
 	}
 
-	if (s.currentSlot + 1) == block.Slot {
+	if (s.CurrentSlot() + 1) == block.Slot {
 
 		if err := s.checkBlockValidity(ctx, block); err != nil {
 			return err
 		}
 
 		log.Infof("Saved block with root %#x and slot %d for initial sync", root, block.Slot)
-		s.currentSlot = block.Slot
+		atomic.StoreUint64(&s.currentSlot, block.Slot)
 
 		s.mutex.Lock()
 		defer s.mutex.Unlock()
@@ -494,5 +505,5 @@ func (s *InitialSync) checkBlockValidity(ctx context.Context, block *pb.BeaconBl
 func (s *InitialSync) isSlotDiffLarge() bool {
 	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
 	epochLimit := params.BeaconConfig().SyncEpochLimit
-	return s.currentSlot+slotsPerEpoch*epochLimit < s.highestObservedSlot
+	return s.CurrentSlot()+slotsPerEpoch*epochLimit < s.HighestObservedSlot()
 }
@@ -173,8 +173,8 @@ func TestSavingBlock_InSync(t *testing.T) {
 
 	ss.stateBuf <- msg2
 
-	if ss.currentSlot == incorrectStateResponse.BeaconState.FinalizedEpoch*params.BeaconConfig().SlotsPerEpoch {
-		t.Fatalf("Beacon state updated incorrectly: %d", ss.currentSlot)
+	if ss.CurrentSlot() == incorrectStateResponse.BeaconState.FinalizedEpoch*params.BeaconConfig().SlotsPerEpoch {
+		t.Fatalf("Beacon state updated incorrectly: %d", ss.CurrentSlot())
 	}
 
 	msg2.Data = stateResponse
@@ -183,7 +183,7 @@ func TestSavingBlock_InSync(t *testing.T) {
 
 	msg1 = getBlockResponseMsg(params.BeaconConfig().GenesisSlot + 1)
 	ss.blockBuf <- msg1
-	if params.BeaconConfig().GenesisSlot+1 != ss.currentSlot {
+	if params.BeaconConfig().GenesisSlot+1 != ss.CurrentSlot() {
 		t.Fatalf("Slot saved when it was not supposed too: %v", stateResponse.BeaconState.FinalizedEpoch*params.BeaconConfig().SlotsPerEpoch)
 	}
 
@@ -195,8 +195,8 @@ func TestSavingBlock_InSync(t *testing.T) {
 
 	br := msg1.Data.(*pb.BeaconBlockResponse)
 
-	if br.Block.Slot != ss.currentSlot {
-		t.Fatalf("Slot not updated despite receiving a valid block: %v", ss.currentSlot)
+	if br.Block.Slot != ss.CurrentSlot() {
+		t.Fatalf("Slot not updated despite receiving a valid block: %v", ss.CurrentSlot())
 	}
 
 	hook.Reset()
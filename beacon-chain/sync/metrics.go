@@ -76,6 +76,22 @@ var (
 		Name: "regsync_sent_exits",
 		Help: "The number of sent exits",
 	})
+	recProposerSlashing = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_received_proposer_slashings",
+		Help: "The number of received proposer slashings",
+	})
+	sentProposerSlashing = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_sent_proposer_slashings",
+		Help: "The number of sent proposer slashings",
+	})
+	recAttesterSlashing = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_received_attester_slashings",
+		Help: "The number of received attester slashings",
+	})
+	sentAttesterSlashing = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_sent_attester_slashings",
+		Help: "The number of sent attester slashings",
+	})
 	chainHeadReq = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "regsync_chain_head_req",
 		Help: "The number of sent attestation requests",
@@ -8,6 +8,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -31,11 +32,14 @@ type chainService interface {
 	IncomingBlockFeed() *event.Feed
 	StateInitializedFeed() *event.Feed
 	CanonicalBlockFeed() *event.Feed
+	BlockRequestFeed() *event.Feed
 }
 
 type operationService interface {
 	IncomingExitFeed() *event.Feed
 	IncomingAttFeed() *event.Feed
+	IncomingProposerSlashingFeed() *event.Feed
+	IncomingAttesterSlashingFeed() *event.Feed
 }
 
 type p2pAPI interface {
@@ -75,7 +79,10 @@ type RegularSync struct {
 	attestationReqByHashBuf  chan p2p.Message
 	unseenAttestationsReqBuf chan p2p.Message
 	exitBuf                  chan p2p.Message
+	proposerSlashingBuf      chan p2p.Message
+	attesterSlashingBuf      chan p2p.Message
 	canonicalBuf             chan *pb.BeaconBlock
+	missingBlockBuf          chan [32]byte
 	highestObservedSlot      uint64
 	blocksAwaitingProcessing map[[32]byte]*pb.BeaconBlock
 }
@@ -92,8 +99,10 @@ type RegularSyncConfig struct {
 	AttestationReqHashBufSize    int
 	UnseenAttestationsReqBufSize int
 	ExitBufferSize               int
+	SlashingBufferSize           int
 	ChainHeadReqBufferSize       int
 	CanonicalBufferSize          int
+	MissingBlockBufferSize       int
 	ChainService                 chainService
 	OperationService             operationService
 	BeaconDB                     *db.BeaconDB
@@ -114,7 +123,9 @@ func DefaultRegularSyncConfig() *RegularSyncConfig {
 		AttestationReqHashBufSize:    100,
 		UnseenAttestationsReqBufSize: 100,
 		ExitBufferSize:               100,
+		SlashingBufferSize:           100,
 		CanonicalBufferSize:          100,
+		MissingBlockBufferSize:       100,
 	}
 }
 
@@ -139,8 +150,11 @@ func NewRegularSyncService(ctx context.Context, cfg *RegularSyncConfig) *Regular
 		attestationReqByHashBuf:  make(chan p2p.Message, cfg.AttestationReqHashBufSize),
 		unseenAttestationsReqBuf: make(chan p2p.Message, cfg.UnseenAttestationsReqBufSize),
 		exitBuf:                  make(chan p2p.Message, cfg.ExitBufferSize),
+		proposerSlashingBuf:      make(chan p2p.Message, cfg.SlashingBufferSize),
+		attesterSlashingBuf:      make(chan p2p.Message, cfg.SlashingBufferSize),
 		chainHeadReqBuf:          make(chan p2p.Message, cfg.ChainHeadReqBufferSize),
 		canonicalBuf:             make(chan *pb.BeaconBlock, cfg.CanonicalBufferSize),
+		missingBlockBuf:          make(chan [32]byte, cfg.MissingBlockBufferSize),
 		blocksAwaitingProcessing: make(map[[32]byte]*pb.BeaconBlock),
 	}
 }
@@ -180,8 +194,11 @@ func (rs *RegularSync) run() {
 	attestationReqSub := rs.p2p.Subscribe(&pb.AttestationRequest{}, rs.attestationReqByHashBuf)
 	unseenAttestationsReqSub := rs.p2p.Subscribe(&pb.UnseenAttestationsRequest{}, rs.unseenAttestationsReqBuf)
 	exitSub := rs.p2p.Subscribe(&pb.VoluntaryExit{}, rs.exitBuf)
+	proposerSlashingSub := rs.p2p.Subscribe(&pb.ProposerSlashing{}, rs.proposerSlashingBuf)
+	attesterSlashingSub := rs.p2p.Subscribe(&pb.AttesterSlashing{}, rs.attesterSlashingBuf)
 	chainHeadReqSub := rs.p2p.Subscribe(&pb.ChainHeadRequest{}, rs.chainHeadReqBuf)
 	canonicalBlockSub := rs.chainService.CanonicalBlockFeed().Subscribe(rs.canonicalBuf)
+	missingBlockSub := rs.chainService.BlockRequestFeed().Subscribe(rs.missingBlockBuf)
 
 	defer announceBlockSub.Unsubscribe()
 	defer blockSub.Unsubscribe()
@@ -194,7 +211,10 @@ func (rs *RegularSync) run() {
 	defer attestationReqSub.Unsubscribe()
 	defer unseenAttestationsReqSub.Unsubscribe()
 	defer exitSub.Unsubscribe()
+	defer proposerSlashingSub.Unsubscribe()
+	defer attesterSlashingSub.Unsubscribe()
 	defer canonicalBlockSub.Unsubscribe()
+	defer missingBlockSub.Unsubscribe()
 
 	for {
 		select {
@@ -211,6 +231,10 @@ func (rs *RegularSync) run() {
 			safelyHandleMessage(rs.handleUnseenAttestationsRequest, msg)
 		case msg := <-rs.exitBuf:
 			safelyHandleMessage(rs.receiveExitRequest, msg)
+		case msg := <-rs.proposerSlashingBuf:
+			safelyHandleMessage(rs.receiveProposerSlashing, msg)
+		case msg := <-rs.attesterSlashingBuf:
+			safelyHandleMessage(rs.receiveAttesterSlashing, msg)
 		case msg := <-rs.blockBuf:
 			safelyHandleMessage(rs.receiveBlock, msg)
 		case msg := <-rs.blockRequestBySlot:
@@ -225,6 +249,8 @@ func (rs *RegularSync) run() {
 			safelyHandleMessage(rs.handleChainHeadRequest, msg)
 		case block := <-rs.canonicalBuf:
 			rs.broadcastCanonicalBlock(rs.ctx, block)
+		case root := <-rs.missingBlockBuf:
+			rs.requestMissingBlock(root)
 		}
 	}
 }
@@ -283,6 +309,17 @@ func (rs *RegularSync) receiveBlockAnnounce(msg p2p.Message) {
 	sendBlockRequestSpan.End()
 }
 
+// requestMissingBlock asks peers for a block which the chain service is missing, such as
+// the parent of a block which arrived out of order.
+func (rs *RegularSync) requestMissingBlock(root [32]byte) {
+	if rs.db.HasBlock(root) {
+		return
+	}
+	log.WithField("blockRoot", fmt.Sprintf("%#x", root)).Debug("Requesting missing block from peers")
+	rs.p2p.Broadcast(&pb.BeaconBlockRequest{Hash: root[:]})
+	sentBlockReq.Inc()
+}
+
 // receiveBlock processes a block from the p2p layer.
 func (rs *RegularSync) receiveBlock(msg p2p.Message) {
 	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.receiveBlock")
@@ -514,6 +551,76 @@ func (rs *RegularSync) receiveExitRequest(msg p2p.Message) {
 	sendExitReqSpan.End()
 }
 
+// receiveProposerSlashing accepts a broadcasted proposer slashing from the p2p layer,
+// discards the slashing if we have gotten it before or if it is not valid against the
+// head state, and sends it to the operations service otherwise.
+func (rs *RegularSync) receiveProposerSlashing(msg p2p.Message) {
+	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.receiveProposerSlashing")
+	defer span.End()
+	recProposerSlashing.Inc()
+	slashing := msg.Data.(*pb.ProposerSlashing)
+	h, err := hashutil.HashProto(slashing)
+	if err != nil {
+		log.Errorf("Could not hash incoming proposer slashing: %v", err)
+		return
+	}
+
+	if rs.db.HasProposerSlashing(h) {
+		log.Debugf("Received, skipping proposer slashing #%x", h)
+		return
+	}
+	beaconState, err := rs.db.HeadState(ctx)
+	if err != nil {
+		log.Errorf("Failed to get head state: %v", err)
+		return
+	}
+	if err := blocks.VerifyProposerSlashing(beaconState, slashing); err != nil {
+		log.Debugf("Discarding invalid proposer slashing #%x: %v", h, err)
+		return
+	}
+	_, sendSlashingSpan := trace.StartSpan(ctx, "sendProposerSlashing")
+	log.WithField("slashingHash", fmt.Sprintf("%#x", h)).
+		Debug("Forwarding proposer slashing to subscribed services")
+	rs.operationsService.IncomingProposerSlashingFeed().Send(slashing)
+	sentProposerSlashing.Inc()
+	sendSlashingSpan.End()
+}
+
+// receiveAttesterSlashing accepts a broadcasted attester slashing from the p2p layer,
+// discards the slashing if we have gotten it before or if it is not valid against the
+// head state, and sends it to the operations service otherwise.
+func (rs *RegularSync) receiveAttesterSlashing(msg p2p.Message) {
+	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.receiveAttesterSlashing")
+	defer span.End()
+	recAttesterSlashing.Inc()
+	slashing := msg.Data.(*pb.AttesterSlashing)
+	h, err := hashutil.HashProto(slashing)
+	if err != nil {
+		log.Errorf("Could not hash incoming attester slashing: %v", err)
+		return
+	}
+
+	if rs.db.HasAttesterSlashing(h) {
+		log.Debugf("Received, skipping attester slashing #%x", h)
+		return
+	}
+	beaconState, err := rs.db.HeadState(ctx)
+	if err != nil {
+		log.Errorf("Failed to get head state: %v", err)
+		return
+	}
+	if err := blocks.VerifyAttesterSlashing(beaconState, slashing); err != nil {
+		log.Debugf("Discarding invalid attester slashing #%x: %v", h, err)
+		return
+	}
+	_, sendSlashingSpan := trace.StartSpan(ctx, "sendAttesterSlashing")
+	log.WithField("slashingHash", fmt.Sprintf("%#x", h)).
+		Debug("Forwarding attester slashing to subscribed services")
+	rs.operationsService.IncomingAttesterSlashingFeed().Send(slashing)
+	sentAttesterSlashing.Inc()
+	sendSlashingSpan.End()
+}
+
 func (rs *RegularSync) handleBlockRequestByHash(msg p2p.Message) {
 	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.handleBlockRequestByHash")
 	defer span.End()
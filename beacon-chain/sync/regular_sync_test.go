@@ -2,6 +2,7 @@ package sync
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
 	"io/ioutil"
 	"strconv"
@@ -12,8 +13,10 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/forkutils"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -43,6 +46,7 @@ type mockChainService struct {
 	bFeed *event.Feed
 	sFeed *event.Feed
 	cFeed *event.Feed
+	rFeed *event.Feed
 }
 
 func (ms *mockChainService) IncomingBlockFeed() *event.Feed {
@@ -66,6 +70,13 @@ func (ms *mockChainService) CanonicalBlockFeed() *event.Feed {
 	return ms.cFeed
 }
 
+func (ms *mockChainService) BlockRequestFeed() *event.Feed {
+	if ms.rFeed == nil {
+		return new(event.Feed)
+	}
+	return ms.rFeed
+}
+
 type mockOperationService struct{}
 
 func (ms *mockOperationService) IncomingAttFeed() *event.Feed {
@@ -76,6 +87,14 @@ func (ms *mockOperationService) IncomingExitFeed() *event.Feed {
 	return new(event.Feed)
 }
 
+func (ms *mockOperationService) IncomingProposerSlashingFeed() *event.Feed {
+	return new(event.Feed)
+}
+
+func (ms *mockOperationService) IncomingAttesterSlashingFeed() *event.Feed {
+	return new(event.Feed)
+}
+
 func setupService(t *testing.T, db *db.BeaconDB) *RegularSync {
 	cfg := &RegularSyncConfig{
 		BlockAnnounceBufferSize: 0,
@@ -606,6 +625,165 @@ func TestReceiveExitReq_OK(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Forwarding validator exit request to subscribed services")
 }
 
+// setupSlashingHeadState saves a head state of four validators, the last of which is
+// already slashed, and returns the keys of the validators.
+func setupSlashingHeadState(t *testing.T, beaconDB *db.BeaconDB) (*pb.BeaconState, []*bls.SecretKey) {
+	privKeys := make([]*bls.SecretKey, 4)
+	validators := make([]*pb.Validator, len(privKeys))
+	for i := 0; i < len(privKeys); i++ {
+		priv, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		privKeys[i] = priv
+		validators[i] = &pb.Validator{
+			Pubkey:       priv.PublicKey().Marshal(),
+			ExitEpoch:    params.BeaconConfig().FarFutureEpoch,
+			SlashedEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	validators[3].SlashedEpoch = params.BeaconConfig().GenesisEpoch
+	beaconState := &pb.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot,
+		Fork:              &pb.Fork{Epoch: params.BeaconConfig().GenesisEpoch},
+		ValidatorRegistry: validators,
+	}
+	block := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot}
+	if err := beaconDB.SaveBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.UpdateChainHead(block, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	return beaconState, privKeys
+}
+
+// signedProposerSlashing creates a proposer slashing of the given proposer, with both
+// proposals signed by the proposer.
+func signedProposerSlashing(t *testing.T, beaconState *pb.BeaconState, privKeys []*bls.SecretKey, proposerIdx uint64) *pb.ProposerSlashing {
+	proposal := &pb.ProposalSignedData{Slot: beaconState.Slot, BlockRootHash32: []byte{1}}
+	root, err := hashutil.HashProto(proposal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutils.DomainVersion(beaconState.Fork, params.BeaconConfig().GenesisEpoch, params.BeaconConfig().DomainProposal)
+	signature := privKeys[proposerIdx].Sign(root[:], domain).Marshal()
+	return &pb.ProposerSlashing{
+		ProposerIndex:       proposerIdx,
+		ProposalData_1:      proposal,
+		ProposalSignature_1: signature,
+		ProposalData_2:      proposal,
+		ProposalSignature_2: signature,
+	}
+}
+
+// signedAttesterSlashing creates an attester slashing of two surrounding votes, each signed
+// by its validators with all custody bits set.
+func signedAttesterSlashing(t *testing.T, beaconState *pb.BeaconState, privKeys []*bls.SecretKey, indices1 []uint64, indices2 []uint64) *pb.AttesterSlashing {
+	slashableAttestation := func(justifiedEpoch uint64, indices []uint64) *pb.SlashableAttestation {
+		att := &pb.SlashableAttestation{
+			Data:             &pb.AttestationData{Slot: beaconState.Slot, JustifiedEpoch: justifiedEpoch},
+			ValidatorIndices: indices,
+			CustodyBitfield:  []byte{byte(1<<uint(len(indices)) - 1)},
+		}
+		root, err := hashutil.HashProto(&pb.AttestationDataAndCustodyBit{Data: att.Data, CustodyBit: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		domain := forkutils.DomainVersion(beaconState.Fork, params.BeaconConfig().GenesisEpoch, params.BeaconConfig().DomainAttestation)
+		var sigs []*bls.Signature
+		for _, idx := range indices {
+			sigs = append(sigs, privKeys[idx].Sign(root[:], domain))
+		}
+		att.AggregateSignature = bls.AggregateSignatures(sigs).Marshal()
+		return att
+	}
+	return &pb.AttesterSlashing{
+		SlashableAttestation_1: slashableAttestation(5, indices1),
+		SlashableAttestation_2: slashableAttestation(4, indices2),
+	}
+}
+
+func TestReceiveSlashings_ForwardsValidSlashings(t *testing.T) {
+	hook := logTest.NewGlobal()
+	os := &mockOperationService{}
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	beaconState, privKeys := setupSlashingHeadState(t, db)
+
+	cfg := &RegularSyncConfig{
+		OperationService: os,
+		P2P:              &mockP2P{},
+		BeaconDB:         db,
+		ChainService:     &mockChainService{},
+	}
+	ss := NewRegularSyncService(context.Background(), cfg)
+
+	exitRoutine := make(chan bool)
+	go func() {
+		ss.run()
+		exitRoutine <- true
+	}()
+
+	ss.proposerSlashingBuf <- p2p.Message{
+		Ctx:  context.Background(),
+		Data: signedProposerSlashing(t, beaconState, privKeys, 1),
+		Peer: p2p.Peer{},
+	}
+	ss.attesterSlashingBuf <- p2p.Message{
+		Ctx:  context.Background(),
+		Data: signedAttesterSlashing(t, beaconState, privKeys, []uint64{1, 2}, []uint64{2}),
+		Peer: p2p.Peer{},
+	}
+	ss.cancel()
+	<-exitRoutine
+	testutil.AssertLogsContain(t, hook, "Forwarding proposer slashing to subscribed services")
+	testutil.AssertLogsContain(t, hook, "Forwarding attester slashing to subscribed services")
+}
+
+func TestReceiveSlashings_DiscardsInvalidSlashings(t *testing.T) {
+	hook := logTest.NewGlobal()
+	os := &mockOperationService{}
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	beaconState, privKeys := setupSlashingHeadState(t, db)
+
+	cfg := &RegularSyncConfig{
+		OperationService: os,
+		P2P:              &mockP2P{},
+		BeaconDB:         db,
+		ChainService:     &mockChainService{},
+	}
+	ss := NewRegularSyncService(context.Background(), cfg)
+
+	exitRoutine := make(chan bool)
+	go func() {
+		ss.run()
+		exitRoutine <- true
+	}()
+
+	// The proposals are signed by validator 2 instead of the slashed proposer.
+	proposerSlashing := signedProposerSlashing(t, beaconState, privKeys, 2)
+	proposerSlashing.ProposerIndex = 1
+	ss.proposerSlashingBuf <- p2p.Message{
+		Ctx:  context.Background(),
+		Data: proposerSlashing,
+		Peer: p2p.Peer{},
+	}
+	// Validator 3 is the only validator in both attestations, and it is already slashed.
+	ss.attesterSlashingBuf <- p2p.Message{
+		Ctx:  context.Background(),
+		Data: signedAttesterSlashing(t, beaconState, privKeys, []uint64{1, 3}, []uint64{2, 3}),
+		Peer: p2p.Peer{},
+	}
+	ss.cancel()
+	<-exitRoutine
+	testutil.AssertLogsContain(t, hook, "Discarding invalid proposer slashing")
+	testutil.AssertLogsContain(t, hook, "Discarding invalid attester slashing")
+	testutil.AssertLogsDoNotContain(t, hook, "Forwarding proposer slashing to subscribed services")
+	testutil.AssertLogsDoNotContain(t, hook, "Forwarding attester slashing to subscribed services")
+}
+
 func TestHandleAttReq_HashNotFound(t *testing.T) {
 	hook := logTest.NewGlobal()
 	os := &mockOperationService{}
@@ -872,3 +1050,26 @@ func TestSafelyHandleMessage_NoData(t *testing.T) {
 		t.Errorf("Message logged was not what was expected: %s", entry.Data["msg"])
 	}
 }
+
+func TestRequestMissingBlock_OK(t *testing.T) {
+	hook := logTest.NewGlobal()
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ss := setupService(t, db)
+
+	block := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot}
+	if err := db.SaveBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	blockRoot, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Blocks which already exist locally are not requested.
+	ss.requestMissingBlock(blockRoot)
+	testutil.AssertLogsDoNotContain(t, hook, "Requesting missing block from peers")
+
+	ss.requestMissingBlock([32]byte{'a'})
+	testutil.AssertLogsContain(t, hook, "Requesting missing block from peers")
+}
@@ -45,6 +45,7 @@ func NewSyncService(ctx context.Context, cfg *Config) *Service {
 	rsCfg.ChainService = cfg.ChainService
 	rsCfg.BeaconDB = cfg.BeaconDB
 	rsCfg.P2P = cfg.P2P
+	rsCfg.OperationService = cfg.OperationService
 
 	sq := NewQuerierService(ctx, sqCfg)
 	rs := NewRegularSyncService(ctx, rsCfg)
@@ -91,6 +92,17 @@ func (ss *Service) Status() error {
 	return nil
 }
 
+// IsSynced checks if the node is currently synced with the rest of the network.
+func (ss *Service) IsSynced() (bool, error) {
+	return ss.Querier.IsSynced()
+}
+
+// InitialSyncProgress returns the slot of the latest block processed by initial sync,
+// along with the highest slot announced by the peers.
+func (ss *Service) InitialSyncProgress() (currentSlot uint64, highestObservedSlot uint64) {
+	return ss.InitialSync.CurrentSlot(), ss.InitialSync.HighestObservedSlot()
+}
+
 func (ss *Service) run() {
 	ss.Querier.Start()
 	synced, err := ss.Querier.IsSynced()
@@ -98,3 +98,11 @@ func TestStatus_NotSynced(t *testing.T) {
 		t.Errorf("Wanted %v, but got %v", querierErr, serviceNotSynced.Status())
 	}
 }
+
+func TestNewSyncService_PassesOperationService(t *testing.T) {
+	service, db := setupTestSyncService(t, true)
+	defer internal.TeardownDB(t, db)
+	if service.RegularSync.operationsService == nil {
+		t.Error("Expected regular sync to forward received operations to the operations service")
+	}
+}
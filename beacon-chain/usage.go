@@ -75,8 +75,20 @@ var appHelpFlagGroups = []flagGroup{
 			utils.RPCPort,
 			utils.CertFlag,
 			utils.KeyFlag,
+			utils.ClientCAFlag,
+			utils.ClientCertFlag,
+			utils.ClientKeyFlag,
+			utils.GRPCGatewayPort,
+			utils.GRPCGatewayHost,
+			utils.RPCRateLimitFlag,
+			utils.RPCRateBurstFlag,
+			utils.RPCMethodConcurrencyFlag,
+			utils.RPCMaxMsgSizeFlag,
+			utils.EnableAdminRPCFlag,
 			utils.GenesisJSON,
 			utils.EnableDBCleanup,
+			utils.StateStorageFlag,
+			utils.PerformanceRetentionFlag,
 			utils.ChainStartDelay,
 		},
 	},
@@ -38,21 +38,121 @@ var (
 		Name:  "tls-key",
 		Usage: "Key for secure gRPC. Pass this and the tls-cert flag in order to use gRPC securely.",
 	}
+	// ClientCAFlag defines a flag for the CA bundle of the certificates which RPC clients
+	// must present to connect to the node.
+	ClientCAFlag = cli.StringFlag{
+		Name:  "tls-client-ca",
+		Usage: "CA bundle verifying the certificates of the RPC clients. Only clients with a certificate issued by one of its CAs can connect.",
+	}
+	// ClientCertFlag defines a flag for the certificate presented when connecting to the RPC
+	// server of a node which verifies its clients.
+	ClientCertFlag = cli.StringFlag{
+		Name:  "tls-client-cert",
+		Usage: "Certificate presented to RPC servers which require client certificates. Pass this and the tls-client-key flag together.",
+	}
+	// ClientKeyFlag defines a flag for the key of the client certificate.
+	ClientKeyFlag = cli.StringFlag{
+		Name:  "tls-client-key",
+		Usage: "Key of the certificate presented to RPC servers which require client certificates",
+	}
+	// GRPCGatewayPort defines the port of the HTTP/JSON gateway to the gRPC API of the
+	// beacon node. The gateway is disabled if it is not set.
+	GRPCGatewayPort = cli.IntFlag{
+		Name:  "grpc-gateway-port",
+		Usage: "Port of the HTTP/JSON gateway to the RPC API, which is only served if this flag is set",
+	}
+	// GRPCGatewayHost defines the host the HTTP/JSON gateway listens on. The gateway is only
+	// reachable from the machine of the beacon node by default.
+	GRPCGatewayHost = cli.StringFlag{
+		Name:  "grpc-gateway-host",
+		Usage: "Host the HTTP/JSON gateway listens on. The gateway requires client certificates issued by the tls-client-ca bundle if it is set.",
+		Value: "127.0.0.1",
+	}
+	// RPCRateLimitFlag defines the number of RPC calls per second allowed to each client
+	// address. A validator client makes the calls of all of its keys from a single address.
+	RPCRateLimitFlag = cli.Float64Flag{
+		Name:  "rpc-rate-limit",
+		Usage: "RPC calls per second allowed to each client address. A validator client makes the calls of all its keys from one address, so raise the limit for validator clients running many keys. The calls are not rate limited if it is 0.",
+		Value: 50,
+	}
+	// RPCRateBurstFlag defines the number of RPC calls a client can make at once before being
+	// rate limited.
+	RPCRateBurstFlag = cli.IntFlag{
+		Name:  "rpc-rate-burst",
+		Usage: "RPC calls a client address can make at once before being rate limited",
+		Value: 100,
+	}
+	// RPCMethodConcurrencyFlag defines caps of the concurrent calls to RPC methods.
+	RPCMethodConcurrencyFlag = cli.StringSliceFlag{
+		Name:  "rpc-max-concurrent-calls",
+		Usage: "Cap of the concurrent calls to an RPC method, as Service/Method=limit. ProposerService/ComputeStateRoot is capped to 4 unless set, and a limit of 0 lifts a cap.",
+	}
+	// RPCMaxMsgSizeFlag defines the maximum size of the messages received by the RPC server.
+	RPCMaxMsgSizeFlag = cli.IntFlag{
+		Name:  "rpc-max-msg-size",
+		Usage: "Maximum size in bytes of the messages received by the RPC server",
+		Value: 4 << 20,
+	}
+	// EnableAdminRPCFlag enables the admin RPC methods, which give full access to the DB of
+	// the node and change its settings. They are disabled unless the flag is set.
+	EnableAdminRPCFlag = cli.BoolFlag{
+		Name:  "enable-admin-rpc",
+		Usage: "Serve the admin RPC methods, which stream DB backups and change the log level, on the RPC port. Only enable it if the RPC port is restricted to node operators, e.g. with tls-client-ca.",
+	}
 	// GenesisJSON defines a flag for bootstrapping validators from genesis JSON.
 	// If this flag is not specified, beacon node will bootstrap validators from code from state.go.
 	GenesisJSON = cli.StringFlag{
 		Name:  "genesis-json",
 		Usage: "Beacon node will bootstrap genesis state defined in genesis.json",
 	}
-	// EnableDBCleanup tells the beacon node to automatically clean DB content such as block vote cache.
+	// EnableDBCleanup tells the beacon node to automatically clean DB content which is no longer
+	// needed after finalization, such as orphaned blocks and stale operations.
 	EnableDBCleanup = cli.BoolFlag{
 		Name:  "enable-db-cleanup",
 		Usage: "Enable automatic DB cleanup routine",
 	}
+	// StateStorageFlag determines which beacon states the beacon node stores in full. States
+	// which are not stored are regenerated from the closest stored state when needed.
+	StateStorageFlag = cli.StringFlag{
+		Name:  "state-storage",
+		Usage: "Which block states are stored in full: \"epoch-boundary\" stores the states at the start of each epoch, \"all\" stores every state",
+		Value: "epoch-boundary",
+	}
+	// PerformanceRetentionFlag defines the number of epochs whose validator performance records
+	// are kept in the DB.
+	PerformanceRetentionFlag = cli.Uint64Flag{
+		Name:  "performance-retention-epochs",
+		Usage: "Number of past epochs whose validator performance records are kept, 1575 (about a week) if not set. All records are kept if it is 0.",
+	}
 	// ChainStartDelay tells the beacon node to wait for a period of time from the current time, before
 	// logging chainstart.
 	ChainStartDelay = cli.Uint64Flag{
 		Name:  "chain-start-delay",
 		Usage: "Delay the chain start so as to make local testing easier",
 	}
+	// StartSlotFlag defines the first slot, counted from genesis, of the blocks exported
+	// into a chain archive.
+	StartSlotFlag = cli.Uint64Flag{
+		Name:  "start-slot",
+		Usage: "First slot since genesis of the exported blocks",
+	}
+	// EndSlotFlag defines the last slot, counted from genesis, of the blocks exported into
+	// a chain archive. The chain head is used if it is not set.
+	EndSlotFlag = cli.Uint64Flag{
+		Name:  "end-slot",
+		Usage: "Last slot since genesis of the exported blocks, defaults to the slot of the chain head",
+	}
+	// WithStateFlag includes the state preceding the exported blocks in a chain archive, so
+	// that it can be imported into an empty database.
+	WithStateFlag = cli.BoolFlag{
+		Name:  "with-state",
+		Usage: "Include the state preceding the exported blocks, required to import the archive into an empty database",
+	}
+	// RPCEndpointFlag defines the RPC endpoint of the running beacon node targeted by the
+	// admin commands.
+	RPCEndpointFlag = cli.StringFlag{
+		Name:  "rpc-endpoint",
+		Usage: "RPC endpoint of the running beacon node",
+		Value: "localhost:4000",
+	}
 )
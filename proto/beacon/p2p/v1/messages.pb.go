@@ -38,6 +38,9 @@ const (
 	Topic_ATTESTATION_ANNOUNCE                Topic = 12
 	Topic_ATTESTATION_REQUEST                 Topic = 13
 	Topic_ATTESTATION_RESPONSE                Topic = 14
+	Topic_PROPOSER_SLASHING                   Topic = 15
+	Topic_ATTESTER_SLASHING                   Topic = 16
+	Topic_VOLUNTARY_EXIT                      Topic = 17
 )
 
 var Topic_name = map[int32]string{
@@ -56,6 +59,9 @@ var Topic_name = map[int32]string{
 	12: "ATTESTATION_ANNOUNCE",
 	13: "ATTESTATION_REQUEST",
 	14: "ATTESTATION_RESPONSE",
+	15: "PROPOSER_SLASHING",
+	16: "ATTESTER_SLASHING",
+	17: "VOLUNTARY_EXIT",
 }
 var Topic_value = map[string]int32{
 	"UNKNOWN":                             0,
@@ -73,6 +79,9 @@ var Topic_value = map[string]int32{
 	"ATTESTATION_ANNOUNCE":                12,
 	"ATTESTATION_REQUEST":                 13,
 	"ATTESTATION_RESPONSE":                14,
+	"PROPOSER_SLASHING":                   15,
+	"ATTESTER_SLASHING":                   16,
+	"VOLUNTARY_EXIT":                      17,
 }
 
 func (x Topic) String() string {
@@ -5453,56 +5462,59 @@ func init() {
 }
 
 var fileDescriptor_messages_d3945b1b4945a296 = []byte{
-	// 813 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0xe2, 0x46,
-	0x14, 0xae, 0x03, 0x09, 0xc9, 0x81, 0x50, 0x67, 0xd2, 0x26, 0x90, 0x36, 0x84, 0x38, 0x8d, 0x4a,
-	0x2b, 0x05, 0x94, 0xf4, 0x2a, 0x97, 0x36, 0x71, 0x4b, 0x7e, 0x6a, 0x52, 0xdb, 0xb4, 0xea, 0xc5,
-	0xca, 0x6b, 0x60, 0x14, 0xa3, 0x25, 0xb6, 0x97, 0x19, 0x50, 0xf2, 0x00, 0xfb, 0x0c, 0xfb, 0x04,
-	0xfb, 0x2e, 0x7b, 0xb9, 0x8f, 0xb0, 0xca, 0x93, 0xac, 0x6c, 0x8f, 0xc1, 0x80, 0x31, 0xac, 0xb4,
-	0x77, 0x9e, 0x73, 0xbe, 0xef, 0x9b, 0xef, 0x3b, 0x73, 0x90, 0x00, 0xc1, 0x1d, 0x38, 0xd4, 0xa9,
-	0xb5, 0xb1, 0xd9, 0x71, 0xec, 0x9a, 0x7b, 0xe1, 0xd6, 0x46, 0xe7, 0xb5, 0x47, 0x4c, 0x88, 0xf9,
-	0x80, 0x49, 0xd5, 0x6f, 0xa2, 0x3d, 0x4c, 0x2d, 0x3c, 0xc0, 0xc3, 0xc7, 0x6a, 0x00, 0xab, 0xba,
-	0x17, 0x6e, 0x75, 0x74, 0x7e, 0x70, 0x14, 0xc7, 0xa5, 0xcf, 0x6e, 0x48, 0x14, 0x6e, 0x60, 0x57,
-	0xf2, 0x9b, 0x52, 0xdf, 0xe9, 0xbc, 0x11, 0x6d, 0xdb, 0x19, 0xda, 0x1d, 0x8c, 0x10, 0xa4, 0x2d,
-	0x93, 0x58, 0x05, 0xae, 0xcc, 0x55, 0x72, 0xaa, 0xff, 0x8d, 0x8e, 0x20, 0x4b, 0xfa, 0x0e, 0x35,
-	0xec, 0xe1, 0x63, 0x1b, 0x0f, 0x0a, 0x6b, 0x65, 0xae, 0x92, 0x56, 0xc1, 0x2b, 0x29, 0x7e, 0x45,
-	0xa8, 0x00, 0x8a, 0x68, 0xa9, 0xf8, 0xed, 0x10, 0x13, 0x1a, 0x27, 0x25, 0x88, 0x50, 0x9a, 0x47,
-	0x4a, 0xcf, 0xda, 0x58, 0x6b, 0xf6, 0x32, 0x6e, 0xee, 0xb2, 0xf7, 0xdc, 0x94, 0x73, 0x15, 0x13,
-	0xd7, 0xb1, 0x09, 0x46, 0x97, 0xb0, 0xde, 0xf6, 0x0a, 0x3e, 0x25, 0x7b, 0x71, 0x52, 0x8d, 0x9f,
-	0x4c, 0x35, 0xca, 0x0d, 0x18, 0x48, 0x86, 0xac, 0x49, 0x29, 0x26, 0xd4, 0xa4, 0x3d, 0xc7, 0xf6,
-	0x03, 0x26, 0x08, 0x88, 0x13, 0xa8, 0x1a, 0xe5, 0x09, 0x2d, 0x28, 0x4a, 0x26, 0xed, 0x58, 0xb8,
-	0x1b, 0x33, 0x8d, 0x43, 0x00, 0x42, 0xcd, 0x01, 0x35, 0xbc, 0x28, 0x2c, 0xd6, 0x96, 0x5f, 0xf1,
-	0xc2, 0xa3, 0x22, 0x6c, 0x62, 0xbb, 0x1b, 0x34, 0x83, 0x01, 0x67, 0xb0, 0xdd, 0xf5, 0x5a, 0x82,
-	0x05, 0x07, 0x71, 0xb2, 0x2c, 0xf6, 0x0d, 0xe4, 0xdb, 0x41, 0xd7, 0xf0, 0xc3, 0x90, 0x02, 0x57,
-	0x4e, 0xad, 0x9a, 0x7f, 0x9b, 0x51, 0xfd, 0x13, 0x11, 0x10, 0xf0, 0x75, 0xcb, 0xec, 0xd9, 0x0d,
-	0x6c, 0x76, 0x99, 0x6f, 0x61, 0x04, 0x3b, 0x91, 0x1a, 0xbb, 0x34, 0x6e, 0x4b, 0x10, 0xa4, 0x23,
-	0xee, 0xfd, 0xef, 0xc9, 0x9b, 0xa4, 0xbe, 0xf6, 0x4d, 0x84, 0x33, 0xd8, 0x0f, 0xaa, 0x1a, 0x35,
-	0x29, 0x6e, 0x98, 0xc4, 0x4a, 0xda, 0xd1, 0xc9, 0x0a, 0xfa, 0xf0, 0xa4, 0x15, 0x7c, 0x15, 0xae,
-	0x0f, 0x43, 0xb2, 0x48, 0x7f, 0x42, 0x2e, 0xf0, 0x64, 0x78, 0xcf, 0x89, 0x57, 0xdb, 0xa2, 0x40,
-	0x22, 0xdb, 0x9e, 0x1c, 0x84, 0xdf, 0x60, 0x37, 0xb2, 0x20, 0xcb, 0x3c, 0x47, 0x77, 0x29, 0xc1,
-	0xb3, 0x3b, 0x25, 0x9a, 0xf8, 0x0c, 0xdf, 0x68, 0x97, 0x7f, 0x82, 0x62, 0xcb, 0x26, 0x18, 0xdb,
-	0x11, 0x04, 0x09, 0x77, 0xa2, 0x1b, 0xd3, 0x1c, 0x9b, 0xfa, 0x0b, 0x72, 0x11, 0xa1, 0xa5, 0xeb,
-	0x18, 0x95, 0x98, 0x22, 0x0a, 0x55, 0x28, 0xdc, 0x0f, 0x1c, 0xd7, 0x21, 0x78, 0xa0, 0xf5, 0x4d,
-	0x62, 0xf5, 0xec, 0x87, 0xc4, 0x71, 0x9e, 0xc1, 0xfe, 0x2c, 0x3e, 0x69, 0xa6, 0xef, 0xb8, 0x79,
-	0xfd, 0xc4, 0xc9, 0xb6, 0x60, 0xc7, 0x65, 0x78, 0x83, 0x30, 0x02, 0x9b, 0x6f, 0x65, 0x51, 0xba,
-	0xb9, 0x0b, 0x78, 0x77, 0xa6, 0xe2, 0xc5, 0x0c, 0x66, 0xb0, 0x7a, 0xcc, 0x59, 0xfc, 0xb2, 0x98,
-	0xf3, 0xf8, 0xe4, 0x98, 0x21, 0x7e, 0xe5, 0x98, 0x73, 0x17, 0xf0, 0xb3, 0x15, 0xe1, 0x14, 0xbe,
-	0xbf, 0xc2, 0xae, 0x43, 0x7a, 0x34, 0x31, 0xdd, 0x2f, 0x90, 0x67, 0xb0, 0xa4, 0x50, 0xaf, 0xc7,
-	0x62, 0x89, 0x51, 0x2e, 0x21, 0xd3, 0x0d, 0x60, 0x2c, 0xc0, 0xd1, 0xa2, 0x00, 0xa1, 0x5a, 0x88,
-	0x17, 0x04, 0xc8, 0xc9, 0x4f, 0x4b, 0xbc, 0x1e, 0x43, 0xd6, 0xc3, 0x24, 0xff, 0x70, 0x73, 0x01,
-	0x24, 0xc1, 0xe5, 0x1d, 0xe4, 0x47, 0x4e, 0x7f, 0x68, 0x53, 0x73, 0xf0, 0x6c, 0xe0, 0xa7, 0xb1,
-	0xd9, 0xd3, 0x45, 0x66, 0xff, 0x0d, 0xd1, 0xbe, 0xf4, 0xf6, 0x28, 0x7a, 0xfc, 0xfd, 0x43, 0x0a,
-	0xd6, 0x75, 0xc7, 0xed, 0x75, 0x50, 0x16, 0x32, 0x2d, 0xe5, 0x56, 0x69, 0xfe, 0xa7, 0xf0, 0xdf,
-	0xa1, 0x22, 0xfc, 0x28, 0xc9, 0x62, 0xbd, 0xa9, 0x18, 0xd2, 0x5d, 0xb3, 0x7e, 0x6b, 0x88, 0x8a,
-	0xd2, 0x6c, 0x29, 0x75, 0x99, 0xe7, 0x50, 0x01, 0x7e, 0x98, 0x6a, 0xa9, 0xf2, 0x3f, 0x2d, 0x59,
-	0xd3, 0xf9, 0x35, 0xf4, 0x2b, 0x9c, 0xc4, 0x75, 0x0c, 0xe9, 0x7f, 0x43, 0xbb, 0x6b, 0xea, 0x86,
-	0xd2, 0xfa, 0x5b, 0x92, 0x55, 0x3e, 0x35, 0xa7, 0xae, 0xca, 0xda, 0x7d, 0x53, 0xd1, 0x64, 0x3e,
-	0x8d, 0xca, 0xf0, 0xb3, 0x24, 0xea, 0xf5, 0x86, 0x7c, 0x65, 0xc4, 0xde, 0xb2, 0x8e, 0x8e, 0xe1,
-	0x70, 0x01, 0x82, 0x89, 0x6c, 0xa0, 0x3d, 0x40, 0xf5, 0x86, 0x78, 0xad, 0x18, 0x0d, 0x59, 0xbc,
-	0x1a, 0x53, 0x33, 0x68, 0x1f, 0x76, 0xa7, 0xea, 0x8c, 0xb0, 0x89, 0x4a, 0x70, 0xc0, 0xb4, 0x34,
-	0x5d, 0xd4, 0x65, 0xa3, 0x21, 0x6a, 0x8d, 0x49, 0xe6, 0xad, 0x48, 0xe6, 0xa0, 0x1f, 0x4a, 0x42,
-	0x24, 0x4a, 0xd8, 0x61, 0xa2, 0x59, 0x8f, 0x24, 0xea, 0xba, 0xec, 0xd5, 0xaf, 0x9b, 0xca, 0x44,
-	0x2e, 0xe7, 0xf9, 0x88, 0x76, 0x42, 0xb5, 0xed, 0x59, 0xca, 0x58, 0x2c, 0x2f, 0xe5, 0x3e, 0xbe,
-	0x94, 0xb8, 0x4f, 0x2f, 0x25, 0xee, 0xf3, 0x4b, 0x89, 0x6b, 0x6f, 0xf8, 0x7f, 0xca, 0xfe, 0xf8,
-	0x12, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x91, 0x83, 0xcb, 0xf3, 0x09, 0x00, 0x00,
+	// 850 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdb, 0x72, 0xda, 0x56,
+	0x14, 0xad, 0x7c, 0x89, 0x93, 0x0d, 0x26, 0xf2, 0x71, 0x13, 0x63, 0xb7, 0xc1, 0x8e, 0xd2, 0x4c,
+	0x69, 0x67, 0x02, 0x13, 0xf7, 0x29, 0x8f, 0x12, 0x3e, 0x8d, 0x48, 0xa8, 0x44, 0x75, 0x49, 0x9b,
+	0x87, 0xce, 0xa9, 0x80, 0x33, 0x16, 0x53, 0x2c, 0xa9, 0x9c, 0x03, 0x63, 0x7f, 0x40, 0xbf, 0xa1,
+	0xbf, 0xd4, 0xc7, 0x7e, 0x42, 0xc7, 0x2f, 0xfd, 0x8d, 0x8e, 0x6e, 0x20, 0x40, 0x08, 0x3a, 0x93,
+	0x37, 0xb4, 0xf7, 0x5a, 0x6b, 0xaf, 0xb5, 0xcf, 0x7e, 0x00, 0xa4, 0x60, 0xec, 0x73, 0xbf, 0xd9,
+	0xa3, 0x4e, 0xdf, 0xf7, 0x9a, 0xc1, 0x65, 0xd0, 0x9c, 0xbe, 0x6e, 0xde, 0x50, 0xc6, 0x9c, 0x6b,
+	0xca, 0x1a, 0x51, 0x13, 0x3d, 0xa5, 0xdc, 0xa5, 0x63, 0x3a, 0xb9, 0x69, 0xc4, 0xb0, 0x46, 0x70,
+	0x19, 0x34, 0xa6, 0xaf, 0xcf, 0xce, 0xf3, 0xb8, 0xfc, 0x2e, 0x48, 0x89, 0xd2, 0x3b, 0x38, 0x56,
+	0xa2, 0xa6, 0x32, 0xf2, 0xfb, 0xbf, 0xc9, 0x9e, 0xe7, 0x4f, 0xbc, 0x3e, 0x45, 0x08, 0xf6, 0x5c,
+	0x87, 0xb9, 0x55, 0xe1, 0x42, 0xa8, 0x97, 0x8d, 0xe8, 0x37, 0x3a, 0x87, 0x12, 0x1b, 0xf9, 0x9c,
+	0x78, 0x93, 0x9b, 0x1e, 0x1d, 0x57, 0x77, 0x2e, 0x84, 0xfa, 0x9e, 0x01, 0x61, 0x49, 0x8b, 0x2a,
+	0x52, 0x1d, 0x50, 0x46, 0xcb, 0xa0, 0xbf, 0x4f, 0x28, 0xe3, 0x79, 0x52, 0x92, 0x0c, 0xb5, 0x55,
+	0xa4, 0x72, 0x67, 0xce, 0xb4, 0x96, 0x87, 0x09, 0x2b, 0xc3, 0xfe, 0x14, 0x16, 0x9c, 0x1b, 0x94,
+	0x05, 0xbe, 0xc7, 0x28, 0x7a, 0x03, 0xfb, 0xbd, 0xb0, 0x10, 0x51, 0x4a, 0x97, 0x2f, 0x1a, 0xf9,
+	0x9b, 0x69, 0x64, 0xb9, 0x31, 0x03, 0x61, 0x28, 0x39, 0x9c, 0x53, 0xc6, 0x1d, 0x3e, 0xf4, 0xbd,
+	0xea, 0x4e, 0xb1, 0x80, 0x3c, 0x87, 0x1a, 0x59, 0x9e, 0x64, 0xc3, 0xa9, 0xe2, 0xf0, 0xbe, 0x4b,
+	0x07, 0x39, 0xdb, 0x78, 0x06, 0xc0, 0xb8, 0x33, 0xe6, 0x24, 0x8c, 0x92, 0xc4, 0x7a, 0x14, 0x55,
+	0xc2, 0xf0, 0xe8, 0x14, 0x1e, 0x52, 0x6f, 0x10, 0x37, 0xe3, 0x05, 0x1f, 0x50, 0x6f, 0x10, 0xb6,
+	0x24, 0x17, 0xce, 0xf2, 0x64, 0x93, 0xd8, 0xef, 0xa0, 0xd2, 0x8b, 0xbb, 0x24, 0x0a, 0xc3, 0xaa,
+	0xc2, 0xc5, 0xee, 0xb6, 0xf9, 0x0f, 0x13, 0x6a, 0xf4, 0xc5, 0x24, 0x04, 0x62, 0xcb, 0x75, 0x86,
+	0x9e, 0x4a, 0x9d, 0x41, 0xe2, 0x5b, 0x9a, 0xc2, 0x51, 0xa6, 0x96, 0x0c, 0xcd, 0xbb, 0x12, 0x04,
+	0x7b, 0x19, 0xf7, 0xd1, 0xef, 0xf9, 0x9b, 0xec, 0xfe, 0xdf, 0x37, 0x91, 0x5e, 0xc1, 0x49, 0x5c,
+	0x35, 0xb9, 0xc3, 0xa9, 0xea, 0x30, 0xb7, 0xe8, 0x46, 0xe7, 0x27, 0x18, 0xc1, 0x8b, 0x4e, 0xf0,
+	0x17, 0x38, 0x5e, 0x40, 0x26, 0x91, 0xbe, 0x87, 0x72, 0xec, 0x89, 0x84, 0xcf, 0x49, 0xb7, 0xbb,
+	0xa2, 0x58, 0xa2, 0xd4, 0x9b, 0x7f, 0x48, 0xdf, 0xc0, 0x71, 0xe6, 0x40, 0x36, 0x79, 0xce, 0xde,
+	0x52, 0x81, 0xe7, 0x60, 0x41, 0xb4, 0xf0, 0x19, 0x3e, 0xd1, 0x2d, 0x7f, 0x01, 0xa7, 0xb6, 0xc7,
+	0x28, 0xf5, 0x32, 0x08, 0x96, 0xde, 0xc4, 0x20, 0xa7, 0x39, 0x33, 0xf5, 0x16, 0xca, 0x19, 0xa1,
+	0x8d, 0xe7, 0x98, 0x95, 0x58, 0x20, 0x4a, 0x0d, 0xa8, 0x76, 0xc7, 0x7e, 0xe0, 0x33, 0x3a, 0x36,
+	0x47, 0x0e, 0x73, 0x87, 0xde, 0x75, 0xe1, 0x3a, 0x5f, 0xc1, 0xc9, 0x32, 0xbe, 0x68, 0xa7, 0x7f,
+	0x08, 0xab, 0xfa, 0x85, 0x9b, 0xb5, 0xe1, 0x28, 0x48, 0xf0, 0x84, 0x25, 0x84, 0x64, 0xbf, 0xf5,
+	0x75, 0xe9, 0x56, 0x06, 0x88, 0xc1, 0x52, 0x25, 0x8c, 0x19, 0xef, 0x60, 0xfb, 0x98, 0xcb, 0xf8,
+	0x4d, 0x31, 0x57, 0xf1, 0xc5, 0x31, 0x53, 0xfc, 0xd6, 0x31, 0x57, 0x06, 0x88, 0xcb, 0x15, 0xe9,
+	0x25, 0x3c, 0xbe, 0xa2, 0x81, 0xcf, 0x86, 0xbc, 0x30, 0xdd, 0x57, 0x50, 0x49, 0x60, 0x45, 0xa1,
+	0x7e, 0x9d, 0x89, 0x15, 0x46, 0x79, 0x03, 0x07, 0x83, 0x18, 0x96, 0x04, 0x38, 0x5f, 0x17, 0x20,
+	0x55, 0x4b, 0xf1, 0x92, 0x04, 0x65, 0x7c, 0xbb, 0xc1, 0xeb, 0x73, 0x28, 0xe1, 0xdb, 0x62, 0xa3,
+	0x41, 0x2c, 0x53, 0xe8, 0xb2, 0x03, 0x95, 0xa9, 0x3f, 0x9a, 0x78, 0xdc, 0x19, 0xdf, 0x11, 0x7a,
+	0x3b, 0x33, 0xfb, 0x72, 0x9d, 0xd9, 0x0f, 0x29, 0x3a, 0x92, 0x3e, 0x9c, 0x66, 0x3f, 0xbf, 0xfd,
+	0x77, 0x17, 0xf6, 0x2d, 0x3f, 0x18, 0xf6, 0x51, 0x09, 0x0e, 0x6c, 0xed, 0xbd, 0xa6, 0xff, 0xa4,
+	0x89, 0x9f, 0xa1, 0x53, 0x78, 0xa2, 0x60, 0xb9, 0xa5, 0x6b, 0x44, 0xe9, 0xe8, 0xad, 0xf7, 0x44,
+	0xd6, 0x34, 0xdd, 0xd6, 0x5a, 0x58, 0x14, 0x50, 0x15, 0x3e, 0x5f, 0x68, 0x19, 0xf8, 0x47, 0x1b,
+	0x9b, 0x96, 0xb8, 0x83, 0xbe, 0x86, 0x17, 0x79, 0x1d, 0xa2, 0x7c, 0x24, 0x66, 0x47, 0xb7, 0x88,
+	0x66, 0xff, 0xa0, 0x60, 0x43, 0xdc, 0x5d, 0x51, 0x37, 0xb0, 0xd9, 0xd5, 0x35, 0x13, 0x8b, 0x7b,
+	0xe8, 0x02, 0xbe, 0x54, 0x64, 0xab, 0xa5, 0xe2, 0x2b, 0x92, 0x3b, 0x65, 0x1f, 0x3d, 0x87, 0x67,
+	0x6b, 0x10, 0x89, 0xc8, 0x03, 0xf4, 0x14, 0x50, 0x4b, 0x95, 0xdb, 0x1a, 0x51, 0xb1, 0x7c, 0x35,
+	0xa3, 0x1e, 0xa0, 0x13, 0x38, 0x5e, 0xa8, 0x27, 0x84, 0x87, 0xa8, 0x06, 0x67, 0x89, 0x96, 0x69,
+	0xc9, 0x16, 0x26, 0xaa, 0x6c, 0xaa, 0xf3, 0xcc, 0x8f, 0x32, 0x99, 0xe3, 0x7e, 0x2a, 0x09, 0x99,
+	0x28, 0x69, 0x27, 0x11, 0x2d, 0x85, 0x24, 0xd9, 0xb2, 0x70, 0x58, 0x6f, 0xeb, 0xda, 0x5c, 0xae,
+	0x1c, 0xfa, 0xc8, 0x76, 0x52, 0xb5, 0xc3, 0x65, 0xca, 0x4c, 0xac, 0x82, 0x9e, 0xc0, 0x51, 0xd7,
+	0xd0, 0xbb, 0xba, 0x89, 0x0d, 0x62, 0x76, 0x64, 0x53, 0x6d, 0x6b, 0x6f, 0xc5, 0xc7, 0x61, 0x39,
+	0x26, 0x64, 0xcb, 0x22, 0x42, 0x50, 0xf9, 0xa0, 0x77, 0x6c, 0xcd, 0x92, 0x8d, 0x8f, 0x04, 0xff,
+	0xdc, 0xb6, 0xc4, 0x23, 0xa5, 0xfc, 0xd7, 0x7d, 0x4d, 0xf8, 0xfb, 0xbe, 0x26, 0xfc, 0x73, 0x5f,
+	0x13, 0x7a, 0x0f, 0xa2, 0xbf, 0x75, 0xdf, 0xfd, 0x37, 0x00, 0xd5, 0x57, 0x1e, 0x9d, 0x35, 0x0a,
+	0x00, 0x00,
 }
@@ -0,0 +1,930 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/p2p/v1/performance.proto
+
+package ethereum_beacon_p2p_v1
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// EpochPerformance records how the transition at the end of an epoch changed the balance
+// of each validator of the registry.
+type EpochPerformance struct {
+	Epoch                uint64                  `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Validators           []*ValidatorPerformance `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *EpochPerformance) Reset()         { *m = EpochPerformance{} }
+func (m *EpochPerformance) String() string { return proto.CompactTextString(m) }
+func (*EpochPerformance) ProtoMessage()    {}
+func (*EpochPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9510160325c81281, []int{0}
+}
+func (m *EpochPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochPerformance.Merge(m, src)
+}
+func (m *EpochPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochPerformance proto.InternalMessageInfo
+
+func (m *EpochPerformance) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochPerformance) GetValidators() []*ValidatorPerformance {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// ValidatorPerformance breaks down the balance change of a validator in an epoch transition
+// into reward and penalty components, in Gwei. Rewards are positive and penalties negative.
+// The attestation components are computed from the attestations of the previous epoch.
+type ValidatorPerformance struct {
+	ValidatorIndex            uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	FfgSourceDelta            int64    `protobuf:"varint,2,opt,name=ffg_source_delta,json=ffgSourceDelta,proto3" json:"ffg_source_delta,omitempty"`
+	FfgTargetDelta            int64    `protobuf:"varint,3,opt,name=ffg_target_delta,json=ffgTargetDelta,proto3" json:"ffg_target_delta,omitempty"`
+	ChainHeadDelta            int64    `protobuf:"varint,4,opt,name=chain_head_delta,json=chainHeadDelta,proto3" json:"chain_head_delta,omitempty"`
+	InclusionDistanceDelta    int64    `protobuf:"varint,5,opt,name=inclusion_distance_delta,json=inclusionDistanceDelta,proto3" json:"inclusion_distance_delta,omitempty"`
+	InactivityPenaltyDelta    int64    `protobuf:"varint,6,opt,name=inactivity_penalty_delta,json=inactivityPenaltyDelta,proto3" json:"inactivity_penalty_delta,omitempty"`
+	AttestationInclusionDelta int64    `protobuf:"varint,7,opt,name=attestation_inclusion_delta,json=attestationInclusionDelta,proto3" json:"attestation_inclusion_delta,omitempty"`
+	CrosslinkDelta            int64    `protobuf:"varint,8,opt,name=crosslink_delta,json=crosslinkDelta,proto3" json:"crosslink_delta,omitempty"`
+	SlashingPenaltyDelta      int64    `protobuf:"varint,9,opt,name=slashing_penalty_delta,json=slashingPenaltyDelta,proto3" json:"slashing_penalty_delta,omitempty"`
+	InclusionDistance         uint64   `protobuf:"varint,10,opt,name=inclusion_distance,json=inclusionDistance,proto3" json:"inclusion_distance,omitempty"`
+	Balance                   uint64   `protobuf:"varint,11,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *ValidatorPerformance) Reset()         { *m = ValidatorPerformance{} }
+func (m *ValidatorPerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformance) ProtoMessage()    {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9510160325c81281, []int{1}
+}
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
+func (m *ValidatorPerformance) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetFfgSourceDelta() int64 {
+	if m != nil {
+		return m.FfgSourceDelta
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetFfgTargetDelta() int64 {
+	if m != nil {
+		return m.FfgTargetDelta
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetChainHeadDelta() int64 {
+	if m != nil {
+		return m.ChainHeadDelta
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetInclusionDistanceDelta() int64 {
+	if m != nil {
+		return m.InclusionDistanceDelta
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetInactivityPenaltyDelta() int64 {
+	if m != nil {
+		return m.InactivityPenaltyDelta
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetAttestationInclusionDelta() int64 {
+	if m != nil {
+		return m.AttestationInclusionDelta
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetCrosslinkDelta() int64 {
+	if m != nil {
+		return m.CrosslinkDelta
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetSlashingPenaltyDelta() int64 {
+	if m != nil {
+		return m.SlashingPenaltyDelta
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetInclusionDistance() uint64 {
+	if m != nil {
+		return m.InclusionDistance
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EpochPerformance)(nil), "ethereum.beacon.p2p.v1.EpochPerformance")
+	proto.RegisterType((*ValidatorPerformance)(nil), "ethereum.beacon.p2p.v1.ValidatorPerformance")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/p2p/v1/performance.proto", fileDescriptor_9510160325c81281)
+}
+
+var fileDescriptor_9510160325c81281 = []byte{
+	// 398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x6a, 0xdb, 0x30,
+	0x1c, 0xc7, 0x71, 0xfe, 0x6e, 0xca, 0x48, 0x32, 0x13, 0x82, 0xc7, 0x20, 0x84, 0xc0, 0x88, 0x0f,
+	0x9b, 0x4d, 0xb2, 0x1d, 0x76, 0xda, 0x61, 0x64, 0xb0, 0x40, 0x0f, 0x21, 0x2d, 0xbd, 0x1a, 0x45,
+	0x96, 0x6d, 0x51, 0x47, 0x12, 0x92, 0x62, 0x9a, 0x47, 0xe9, 0x1b, 0xf5, 0xd8, 0x47, 0x28, 0x79,
+	0x92, 0x62, 0x59, 0x76, 0xdd, 0x26, 0x47, 0x7d, 0xbf, 0x9f, 0x8f, 0xf4, 0xb3, 0x2c, 0xf0, 0x8d,
+	0x0b, 0xa6, 0x98, 0xbf, 0xc3, 0x10, 0x31, 0xea, 0xf3, 0x25, 0xf7, 0xb3, 0x85, 0xcf, 0xb1, 0x88,
+	0x98, 0xd8, 0x43, 0x8a, 0xb0, 0xa7, 0x7b, 0x7b, 0x8c, 0x55, 0x82, 0x05, 0x3e, 0xec, 0xbd, 0x82,
+	0xf4, 0xf8, 0x92, 0x7b, 0xd9, 0x62, 0x96, 0x81, 0xe1, 0x3f, 0xce, 0x50, 0xb2, 0x79, 0x35, 0xec,
+	0x11, 0x68, 0xe3, 0x3c, 0x73, 0xac, 0xa9, 0xe5, 0xb6, 0xb6, 0xc5, 0xc2, 0xbe, 0x02, 0x20, 0x83,
+	0x29, 0x09, 0xa1, 0x62, 0x42, 0x3a, 0x8d, 0x69, 0xd3, 0xed, 0x2d, 0xbf, 0x7b, 0x97, 0xb7, 0xf5,
+	0x6e, 0x4b, 0xb2, 0xb6, 0xef, 0xb6, 0xe6, 0xcf, 0x1e, 0x5a, 0x60, 0x74, 0x09, 0xb2, 0xe7, 0x60,
+	0x50, 0x61, 0x01, 0xa1, 0x21, 0xbe, 0x37, 0x63, 0xf4, 0xab, 0x78, 0x9d, 0xa7, 0xb6, 0x0b, 0x86,
+	0x51, 0x14, 0x07, 0x92, 0x1d, 0x04, 0xc2, 0x41, 0x88, 0x53, 0x05, 0x9d, 0xc6, 0xd4, 0x72, 0x9b,
+	0xdb, 0x7e, 0x14, 0xc5, 0xd7, 0x3a, 0x5e, 0xe5, 0x69, 0x49, 0x2a, 0x28, 0x62, 0xac, 0x0c, 0xd9,
+	0xac, 0xc8, 0x1b, 0x1d, 0x57, 0x24, 0x4a, 0x20, 0xa1, 0x41, 0x82, 0x61, 0x68, 0xc8, 0x56, 0x41,
+	0xea, 0xfc, 0x3f, 0x86, 0x61, 0x41, 0xfe, 0x06, 0x0e, 0xa1, 0x28, 0x3d, 0x48, 0xc2, 0x68, 0x10,
+	0x12, 0xa9, 0xf2, 0xe1, 0x8d, 0xd1, 0xd6, 0xc6, 0xb8, 0xea, 0x57, 0xa6, 0xae, 0x99, 0x10, 0x29,
+	0x92, 0x11, 0x75, 0x0c, 0x38, 0xa6, 0x30, 0x55, 0x47, 0x63, 0x76, 0x4a, 0xb3, 0xec, 0x37, 0x45,
+	0x5d, 0x98, 0x7f, 0xc0, 0x57, 0xa8, 0x14, 0x96, 0x0a, 0xaa, 0xfc, 0xd4, 0xda, 0xf9, 0x5a, 0xee,
+	0x6a, 0xf9, 0x4b, 0x0d, 0x59, 0x57, 0x13, 0x68, 0x7f, 0x0e, 0x06, 0x48, 0x30, 0x29, 0x53, 0x42,
+	0xef, 0x8c, 0xf3, 0xc1, 0x7c, 0x5c, 0x19, 0x17, 0xe0, 0x2f, 0x30, 0x96, 0x29, 0x94, 0x09, 0xa1,
+	0xf1, 0xbb, 0x01, 0x3f, 0x6a, 0x7e, 0x54, 0xb6, 0x6f, 0xc6, 0xfb, 0x01, 0xec, 0xf3, 0x2b, 0x71,
+	0x80, 0xfe, 0x79, 0x9f, 0xcf, 0x2e, 0xc3, 0x76, 0x40, 0x77, 0x07, 0x53, 0xcd, 0xf4, 0x34, 0x53,
+	0x2e, 0xff, 0x7e, 0x7a, 0x3c, 0x4d, 0xac, 0xa7, 0xd3, 0xc4, 0x7a, 0x3e, 0x4d, 0xac, 0x5d, 0x47,
+	0x3f, 0xe0, 0x9f, 0x2f, 0x03, 0x00, 0x23, 0x9c, 0xc7, 0x2c, 0xe9, 0x02, 0x00, 0x00,
+}
+
+func (m *EpochPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochPerformance) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPerformance(dAtA, i, uint64(m.Epoch))
+	}
+	if len(m.Validators) > 0 {
+		for _, msg := range m.Validators {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPerformance(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPerformance(dAtA, i, uint64(m.ValidatorIndex))
+	}
+	if m.FfgSourceDelta != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPerformance(dAtA, i, uint64(m.FfgSourceDelta))
+	}
+	if m.FfgTargetDelta != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPerformance(dAtA, i, uint64(m.FfgTargetDelta))
+	}
+	if m.ChainHeadDelta != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPerformance(dAtA, i, uint64(m.ChainHeadDelta))
+	}
+	if m.InclusionDistanceDelta != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPerformance(dAtA, i, uint64(m.InclusionDistanceDelta))
+	}
+	if m.InactivityPenaltyDelta != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPerformance(dAtA, i, uint64(m.InactivityPenaltyDelta))
+	}
+	if m.AttestationInclusionDelta != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPerformance(dAtA, i, uint64(m.AttestationInclusionDelta))
+	}
+	if m.CrosslinkDelta != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintPerformance(dAtA, i, uint64(m.CrosslinkDelta))
+	}
+	if m.SlashingPenaltyDelta != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintPerformance(dAtA, i, uint64(m.SlashingPenaltyDelta))
+	}
+	if m.InclusionDistance != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintPerformance(dAtA, i, uint64(m.InclusionDistance))
+	}
+	if m.Balance != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintPerformance(dAtA, i, uint64(m.Balance))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintPerformance(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *EpochPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovPerformance(uint64(m.Epoch))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovPerformance(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovPerformance(uint64(m.ValidatorIndex))
+	}
+	if m.FfgSourceDelta != 0 {
+		n += 1 + sovPerformance(uint64(m.FfgSourceDelta))
+	}
+	if m.FfgTargetDelta != 0 {
+		n += 1 + sovPerformance(uint64(m.FfgTargetDelta))
+	}
+	if m.ChainHeadDelta != 0 {
+		n += 1 + sovPerformance(uint64(m.ChainHeadDelta))
+	}
+	if m.InclusionDistanceDelta != 0 {
+		n += 1 + sovPerformance(uint64(m.InclusionDistanceDelta))
+	}
+	if m.InactivityPenaltyDelta != 0 {
+		n += 1 + sovPerformance(uint64(m.InactivityPenaltyDelta))
+	}
+	if m.AttestationInclusionDelta != 0 {
+		n += 1 + sovPerformance(uint64(m.AttestationInclusionDelta))
+	}
+	if m.CrosslinkDelta != 0 {
+		n += 1 + sovPerformance(uint64(m.CrosslinkDelta))
+	}
+	if m.SlashingPenaltyDelta != 0 {
+		n += 1 + sovPerformance(uint64(m.SlashingPenaltyDelta))
+	}
+	if m.InclusionDistance != 0 {
+		n += 1 + sovPerformance(uint64(m.InclusionDistance))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovPerformance(uint64(m.Balance))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPerformance(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPerformance(x uint64) (n int) {
+	return sovPerformance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EpochPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerformance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &ValidatorPerformance{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerformance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerformance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FfgSourceDelta", wireType)
+			}
+			m.FfgSourceDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FfgSourceDelta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FfgTargetDelta", wireType)
+			}
+			m.FfgTargetDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FfgTargetDelta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainHeadDelta", wireType)
+			}
+			m.ChainHeadDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainHeadDelta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDistanceDelta", wireType)
+			}
+			m.InclusionDistanceDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionDistanceDelta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityPenaltyDelta", wireType)
+			}
+			m.InactivityPenaltyDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactivityPenaltyDelta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationInclusionDelta", wireType)
+			}
+			m.AttestationInclusionDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationInclusionDelta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrosslinkDelta", wireType)
+			}
+			m.CrosslinkDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CrosslinkDelta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingPenaltyDelta", wireType)
+			}
+			m.SlashingPenaltyDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashingPenaltyDelta |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDistance", wireType)
+			}
+			m.InclusionDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionDistance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerformance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPerformance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPerformance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPerformance
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthPerformance
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowPerformance
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipPerformance(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthPerformance
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthPerformance = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPerformance   = fmt.Errorf("proto: integer overflow")
+)
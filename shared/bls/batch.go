@@ -0,0 +1,195 @@
+package bls
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	gobls "github.com/phoreproject/bls"
+)
+
+// SignatureBatch collects signature checks so that they can be verified together.
+// Checking n signatures one at a time costs 2n pairings, while a batch only costs
+// one pairing per distinct message plus one per signature domain.
+type SignatureBatch struct {
+	items []*batchItem
+}
+
+type batchItem struct {
+	sig         *Signature
+	pubs        []*PublicKey
+	msgs        [][]byte
+	domain      uint64
+	description string
+}
+
+// NewSignatureBatch creates an empty batch of signature checks.
+func NewSignatureBatch() *SignatureBatch {
+	return &SignatureBatch{}
+}
+
+// Add queues the check of a signature of a message by a public key. The description
+// names the signature in the error returned if it does not verify.
+func (b *SignatureBatch) Add(sig *Signature, pub *PublicKey, msg []byte, domain uint64, description string) {
+	b.AddMultiple(sig, []*PublicKey{pub}, [][]byte{msg}, domain, description)
+}
+
+// AddMultiple queues the check of a single signature over several distinct messages, each
+// of them signed by the public key at the same position.
+func (b *SignatureBatch) AddMultiple(sig *Signature, pubKeys []*PublicKey, msgs [][]byte, domain uint64, description string) {
+	b.items = append(b.items, &batchItem{
+		sig:         sig,
+		pubs:        pubKeys,
+		msgs:        msgs,
+		domain:      domain,
+		description: description,
+	})
+}
+
+// AddAggregate queues the check of an aggregate signature of a message by several public keys.
+func (b *SignatureBatch) AddAggregate(sig *Signature, pubKeys []*PublicKey, msg []byte, domain uint64, description string) {
+	aggregate := gobls.NewAggregatePubkey()
+	for _, pub := range pubKeys {
+		aggregate.Aggregate(pub.val)
+	}
+	b.Add(sig, &PublicKey{val: aggregate}, msg, domain, description)
+}
+
+// Len returns the number of queued signature checks.
+func (b *SignatureBatch) Len() int {
+	return len(b.items)
+}
+
+// Verify checks every signature of the batch with a single randomized multi-pairing
+// check per domain. If that check fails, the signatures are checked one at a time so
+// that the returned error names the first invalid one.
+func (b *SignatureBatch) Verify() error {
+	switch len(b.items) {
+	case 0:
+		return nil
+	case 1:
+		return b.items[0].verify()
+	}
+	if ok, err := b.verifyRandomized(rand.Reader); err == nil && ok {
+		return nil
+	}
+	for _, item := range b.items {
+		if err := item.verify(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *batchItem) verify() error {
+	var ok bool
+	if len(i.msgs) == 1 {
+		ok = gobls.Verify(i.msgs[0], i.pubs[0].val, i.sig.val, i.domain)
+	} else {
+		pubs := make([]*gobls.PublicKey, len(i.pubs))
+		for j, pub := range i.pubs {
+			pubs[j] = pub.val
+		}
+		ok = len(pubs) == len(i.msgs) && i.sig.val.VerifyAggregate(pubs, i.msgs, i.domain)
+	}
+	if !ok {
+		return fmt.Errorf("%s signature did not verify", i.description)
+	}
+	return nil
+}
+
+// verifyRandomized multiplies every signature and public key of the batch by a random
+// scalar before summing them up, so that invalid signatures cannot cancel each other out.
+// Public keys signing the same message are summed into one key, which leaves a single
+// pairing per distinct message.
+func (b *SignatureBatch) verifyRandomized(r io.Reader) (bool, error) {
+	type domainGroup struct {
+		sig  *gobls.Signature
+		msgs [][]byte
+		pubs map[string]*gobls.PublicKey
+	}
+	groups := make(map[uint64]*domainGroup)
+	var domains []uint64
+
+	buf := make([]byte, 8)
+	for _, item := range b.items {
+		if len(item.pubs) != len(item.msgs) {
+			return false, nil
+		}
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return false, fmt.Errorf("could not generate random scalar: %v", err)
+		}
+		// A zero scalar would drop the signature from the check.
+		scalar := binary.LittleEndian.Uint64(buf) | 1
+
+		group, ok := groups[item.domain]
+		if !ok {
+			group = &domainGroup{
+				sig:  gobls.NewAggregateSignature(),
+				pubs: make(map[string]*gobls.PublicKey),
+			}
+			groups[item.domain] = group
+			domains = append(domains, item.domain)
+		}
+		group.sig.Aggregate(scaleSignature(item.sig.val, scalar))
+
+		for i, msg := range item.msgs {
+			pub, ok := group.pubs[string(msg)]
+			if !ok {
+				pub = gobls.NewAggregatePubkey()
+				group.pubs[string(msg)] = pub
+				group.msgs = append(group.msgs, msg)
+			}
+			pub.Aggregate(scalePublicKey(item.pubs[i].val, scalar))
+		}
+	}
+
+	for _, domain := range domains {
+		group := groups[domain]
+		pubs := make([]*gobls.PublicKey, len(group.msgs))
+		for i, msg := range group.msgs {
+			pubs[i] = group.pubs[string(msg)]
+		}
+		if !group.sig.VerifyAggregate(pubs, group.msgs, domain) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// scaleSignature multiplies a signature by a scalar using double-and-add. The doubled
+// value is built into a fresh aggregate, so that no aggregate is added to itself.
+func scaleSignature(sig *gobls.Signature, scalar uint64) *gobls.Signature {
+	result := gobls.NewAggregateSignature()
+	addend := gobls.NewAggregateSignature()
+	addend.Aggregate(sig)
+	for ; scalar > 0; scalar >>= 1 {
+		if scalar&1 != 0 {
+			result.Aggregate(addend)
+		}
+		doubled := gobls.NewAggregateSignature()
+		doubled.Aggregate(addend)
+		doubled.Aggregate(addend)
+		addend = doubled
+	}
+	return result
+}
+
+// scalePublicKey multiplies a public key by a scalar using double-and-add. The doubled
+// value is built into a fresh aggregate, so that no aggregate is added to itself.
+func scalePublicKey(pub *gobls.PublicKey, scalar uint64) *gobls.PublicKey {
+	result := gobls.NewAggregatePubkey()
+	addend := gobls.NewAggregatePubkey()
+	addend.Aggregate(pub)
+	for ; scalar > 0; scalar >>= 1 {
+		if scalar&1 != 0 {
+			result.Aggregate(addend)
+		}
+		doubled := gobls.NewAggregatePubkey()
+		doubled.Aggregate(addend)
+		doubled.Aggregate(addend)
+		addend = doubled
+	}
+	return result
+}
@@ -0,0 +1,85 @@
+package bls
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	gobls "github.com/phoreproject/bls"
+)
+
+func TestScaleSignature_MatchesRepeatedAddition(t *testing.T) {
+	priv, _ := RandKey(rand.Reader)
+	sig := priv.Sign([]byte("hello"), 0)
+	pub := priv.PublicKey()
+	for _, scalar := range []uint64{1, 2, 3, 5, 8, 13} {
+		wantSig := gobls.NewAggregateSignature()
+		wantPub := gobls.NewAggregatePubkey()
+		for i := uint64(0); i < scalar; i++ {
+			wantSig.Aggregate(sig.val)
+			wantPub.Aggregate(pub.val)
+		}
+		if got := scaleSignature(sig.val, scalar).Serialize(); !bytes.Equal(got[:], serializeSignature(wantSig)) {
+			t.Errorf("Signature scaled by %d does not match repeated addition", scalar)
+		}
+		if got := scalePublicKey(pub.val, scalar).Serialize(); !bytes.Equal(got[:], serializePublicKey(wantPub)) {
+			t.Errorf("Public key scaled by %d does not match repeated addition", scalar)
+		}
+	}
+}
+
+func TestVerifyRandomized_AcceptsValidBatch(t *testing.T) {
+	batch, _ := randomizedTestBatch(t)
+	// The per-item fallback of Verify would hide a broken grouped check, so it is called directly.
+	ok, err := batch.verifyRandomized(rand.Reader)
+	if err != nil {
+		t.Fatalf("Could not verify batch: %v", err)
+	}
+	if !ok {
+		t.Error("Expected grouped check to accept a valid batch")
+	}
+}
+
+func TestVerifyRandomized_RejectsSwappedSignature(t *testing.T) {
+	batch, sigs := randomizedTestBatch(t)
+	// Two items trade their signatures, which would cancel out without the random scalars.
+	batch.items[1].sig, batch.items[3].sig = sigs[3], sigs[1]
+	ok, err := batch.verifyRandomized(rand.Reader)
+	if err != nil {
+		t.Fatalf("Could not verify batch: %v", err)
+	}
+	if ok {
+		t.Error("Expected grouped check to reject a batch with swapped signatures")
+	}
+}
+
+// randomizedTestBatch creates a valid batch over several messages and domains, along with
+// the signatures of its items.
+func randomizedTestBatch(t *testing.T) (*SignatureBatch, []*Signature) {
+	batch := NewSignatureBatch()
+	var sigs []*Signature
+	for i := 0; i < 6; i++ {
+		priv, err := RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Items 1 and 3 share their domain, but sign different messages.
+		msg := []byte(fmt.Sprintf("message %d", i%4))
+		domain := uint64(i % 2)
+		sig := priv.Sign(msg, domain)
+		sigs = append(sigs, sig)
+		batch.Add(sig, priv.PublicKey(), msg, domain, fmt.Sprintf("item %d", i))
+	}
+	return batch, sigs
+}
+
+func serializeSignature(sig *gobls.Signature) []byte {
+	b := sig.Serialize()
+	return b[:]
+}
+
+func serializePublicKey(pub *gobls.PublicKey) []byte {
+	b := pub.Serialize()
+	return b[:]
+}
@@ -0,0 +1,105 @@
+package bls_test
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+func TestSignatureBatch_Verify(t *testing.T) {
+	batch := bls.NewSignatureBatch()
+	for i := 0; i < 10; i++ {
+		priv, _ := bls.RandKey(rand.Reader)
+		// Half of the signatures share a message and a domain.
+		msg := []byte(fmt.Sprintf("message %d", i%5))
+		domain := uint64(i % 2)
+		batch.Add(priv.Sign(msg, domain), priv.PublicKey(), msg, domain, fmt.Sprintf("item %d", i))
+	}
+	if err := batch.Verify(); err != nil {
+		t.Errorf("Batch did not verify: %v", err)
+	}
+}
+
+func TestSignatureBatch_VerifyAggregate(t *testing.T) {
+	msg := []byte("hello")
+	var pubKeys []*bls.PublicKey
+	var sigs []*bls.Signature
+	for i := 0; i < 10; i++ {
+		priv, _ := bls.RandKey(rand.Reader)
+		pubKeys = append(pubKeys, priv.PublicKey())
+		sigs = append(sigs, priv.Sign(msg, 0))
+	}
+	priv, _ := bls.RandKey(rand.Reader)
+
+	batch := bls.NewSignatureBatch()
+	batch.AddAggregate(bls.AggregateSignatures(sigs), pubKeys, msg, 0, "aggregate")
+	batch.Add(priv.Sign(msg, 0), priv.PublicKey(), msg, 0, "single")
+	if err := batch.Verify(); err != nil {
+		t.Errorf("Batch did not verify: %v", err)
+	}
+}
+
+func TestSignatureBatch_VerifyMultiple(t *testing.T) {
+	priv1, _ := bls.RandKey(rand.Reader)
+	priv2, _ := bls.RandKey(rand.Reader)
+	msg1, msg2 := []byte("custody bit 0"), []byte("custody bit 1")
+	sig := bls.AggregateSignatures([]*bls.Signature{priv1.Sign(msg1, 0), priv2.Sign(msg2, 0)})
+	pubKeys := []*bls.PublicKey{priv1.PublicKey(), priv2.PublicKey()}
+
+	batch := bls.NewSignatureBatch()
+	batch.AddMultiple(sig, pubKeys, [][]byte{msg1, msg2}, 0, "multiple")
+	if err := batch.Verify(); err != nil {
+		t.Errorf("Batch did not verify: %v", err)
+	}
+	priv3, _ := bls.RandKey(rand.Reader)
+	batch.Add(priv3.Sign(msg1, 1), priv3.PublicKey(), msg1, 1, "single")
+	if err := batch.Verify(); err != nil {
+		t.Errorf("Batch did not verify: %v", err)
+	}
+
+	// Swapping the messages of the public keys does not verify.
+	batch = bls.NewSignatureBatch()
+	batch.AddMultiple(sig, pubKeys, [][]byte{msg2, msg1}, 0, "multiple")
+	if err := batch.Verify(); err == nil {
+		t.Error("Expected signature over swapped messages to fail")
+	}
+}
+
+func TestSignatureBatch_NamesInvalidSignature(t *testing.T) {
+	batch := bls.NewSignatureBatch()
+	for i := 0; i < 5; i++ {
+		priv, _ := bls.RandKey(rand.Reader)
+		msg := []byte(fmt.Sprintf("message %d", i))
+		signed := msg
+		if i == 3 {
+			signed = []byte("another message")
+		}
+		batch.Add(priv.Sign(signed, 0), priv.PublicKey(), msg, 0, fmt.Sprintf("item %d", i))
+	}
+
+	err := batch.Verify()
+	if err == nil {
+		t.Fatal("Expected batch with an invalid signature to fail")
+	}
+	if !strings.Contains(err.Error(), "item 3") {
+		t.Errorf("Expected error to name the invalid signature, received %v", err)
+	}
+}
+
+func TestSignatureBatch_SwappedSignaturesFail(t *testing.T) {
+	// Two swapped signatures still sum up to a valid aggregate, which the random
+	// scalars of the batch check must catch.
+	priv1, _ := bls.RandKey(rand.Reader)
+	priv2, _ := bls.RandKey(rand.Reader)
+	msg1, msg2 := []byte("message 1"), []byte("message 2")
+
+	batch := bls.NewSignatureBatch()
+	batch.Add(priv2.Sign(msg2, 0), priv1.PublicKey(), msg1, 0, "first")
+	batch.Add(priv1.Sign(msg1, 0), priv2.PublicKey(), msg2, 0, "second")
+	if err := batch.Verify(); err == nil {
+		t.Error("Expected batch with swapped signatures to fail")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	ds "github.com/ipfs/go-datastore"
@@ -14,8 +15,11 @@ import (
 	libp2p "github.com/libp2p/go-libp2p"
 	host "github.com/libp2p/go-libp2p-host"
 	kaddht "github.com/libp2p/go-libp2p-kad-dht"
+	inet "github.com/libp2p/go-libp2p-net"
+	peer "github.com/libp2p/go-libp2p-peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	rhost "github.com/libp2p/go-libp2p/p2p/host/routed"
+	ma "github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/iputils"
 	"github.com/sirupsen/logrus"
@@ -154,6 +158,41 @@ func (s *Server) Status() error {
 	return nil
 }
 
+// PeerInfo describes a connection of the server to a peer.
+type PeerInfo struct {
+	ID      peer.ID
+	Addr    ma.Multiaddr
+	Inbound bool
+	// Latency is the moving average of the round trip time to the peer, or zero if it was
+	// not measured yet.
+	Latency time.Duration
+}
+
+// ID returns the libp2p peer ID of the server.
+func (s *Server) ID() peer.ID {
+	return s.host.ID()
+}
+
+// Addrs returns the multiaddrs the server listens to.
+func (s *Server) Addrs() []ma.Multiaddr {
+	return s.host.Addrs()
+}
+
+// Peers returns the connections of the server to its peers.
+func (s *Server) Peers() []PeerInfo {
+	conns := s.host.Network().Conns()
+	peers := make([]PeerInfo, 0, len(conns))
+	for _, conn := range conns {
+		peers = append(peers, PeerInfo{
+			ID:      conn.RemotePeer(),
+			Addr:    conn.RemoteMultiaddr(),
+			Inbound: conn.Stat().Direction == inet.DirInbound,
+			Latency: s.host.Peerstore().LatencyEWMA(conn.RemotePeer()),
+		})
+	}
+	return peers
+}
+
 // RegisterTopic with a message and the adapter stack for the given topic. The
 // message type provided will be feed selector for emitting messages received
 // on a given topic.
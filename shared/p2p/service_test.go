@@ -384,6 +384,38 @@ func TestStatus_MinimumPeers(t *testing.T) {
 	}
 }
 
+func TestPeers_ReportsConnections(t *testing.T) {
+	ctx := context.Background()
+	h := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	s := Server{host: h}
+
+	other := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	if err := h.Connect(ctx, other.Peerstore().PeerInfo(other.ID())); err != nil {
+		t.Fatalf("Could not connect to host for test setup")
+	}
+	h.Peerstore().RecordLatency(other.ID(), 10*time.Millisecond)
+
+	peers := s.Peers()
+	if len(peers) != 1 {
+		t.Fatalf("Expected 1 peer, received %d", len(peers))
+	}
+	if peers[0].ID != other.ID() {
+		t.Errorf("Expected peer %s, received %s", other.ID().Pretty(), peers[0].ID.Pretty())
+	}
+	if peers[0].Inbound {
+		t.Error("Expected the connection dialed by the server to be outbound")
+	}
+	if peers[0].Latency != 10*time.Millisecond {
+		t.Errorf("Expected latency of 10ms, received %v", peers[0].Latency)
+	}
+
+	otherServer := Server{host: other}
+	peers = otherServer.Peers()
+	if len(peers) != 1 || !peers[0].Inbound {
+		t.Errorf("Expected 1 inbound connection, received %v", peers)
+	}
+}
+
 func simulateIncomingMessage(t *testing.T, s *Server, topic string, msg proto.Message) error {
 	ctx := context.Background()
 	h := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
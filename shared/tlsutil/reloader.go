@@ -0,0 +1,251 @@
+// Package tlsutil loads the TLS credentials of the gRPC servers and clients from files, and
+// reloads them without a restart when the files change.
+package tlsutil
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "tls")
+
+// modCheckInterval is the interval at which the credential files are checked for changes.
+const modCheckInterval = 10 * time.Second
+
+// Reloader holds a certificate key pair and a CA bundle loaded from files, both optional,
+// and serves them to the TLS handshakes. The credentials are reloaded on SIGHUP and when
+// the files are modified, without interrupting the established connections.
+type Reloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+	lock     sync.RWMutex
+	cert     *tls.Certificate
+	caPool   *x509.CertPool
+	modTimes map[string]time.Time
+}
+
+// NewReloader loads the key pair of the certificate and key files if they are set, and the
+// CA bundle of the CA file if it is set.
+func NewReloader(certFile string, keyFile string, caFile string) (*Reloader, error) {
+	if (certFile == "") != (keyFile == "") {
+		return nil, errors.New("a certificate and its key must be provided together")
+	}
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload loads the credential files again. The previous credentials are kept if the files
+// are invalid.
+func (r *Reloader) Reload() error {
+	modTimes, err := r.fileModTimes()
+	if err != nil {
+		return err
+	}
+	var cert *tls.Certificate
+	if r.certFile != "" {
+		keyPair, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			return fmt.Errorf("could not load key pair: %v", err)
+		}
+		cert = &keyPair
+	}
+	var caPool *x509.CertPool
+	if r.caFile != "" {
+		pem, err := ioutil.ReadFile(r.caFile)
+		if err != nil {
+			return fmt.Errorf("could not read CA bundle: %v", err)
+		}
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificate found in CA bundle %s", r.caFile)
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.cert = cert
+	r.caPool = caPool
+	r.modTimes = modTimes
+	return nil
+}
+
+// Watch reloads the credentials on SIGHUP and when one of the files is modified, until
+// the context is done.
+func (r *Reloader) Watch(ctx context.Context) {
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGHUP)
+	defer signal.Stop(sigc)
+	ticker := time.NewTicker(modCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-sigc:
+			r.reloadAndLog("SIGHUP received")
+		case <-ticker.C:
+			if r.modified() {
+				r.reloadAndLog("files modified")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Reloader) reloadAndLog(reason string) {
+	if err := r.Reload(); err != nil {
+		log.Errorf("Could not reload TLS credentials, keeping the previous ones: %v", err)
+		return
+	}
+	log.WithField("reason", reason).Info("Reloaded TLS credentials")
+}
+
+// ServerConfig returns the TLS config of a server presenting the certificate of the
+// reloader. If the reloader has a CA bundle, clients must present a certificate issued by
+// one of its CAs, and the identity of each authorized client is logged.
+func (r *Reloader) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.lock.RLock()
+			defer r.lock.RUnlock()
+			if r.cert == nil {
+				return nil, errors.New("no server certificate")
+			}
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				NextProtos:   []string{"h2"},
+			}
+			if r.caPool != nil {
+				config.ClientAuth = tls.RequireAndVerifyClientCert
+				config.ClientCAs = r.caPool
+				config.VerifyPeerCertificate = logClientIdentity
+			}
+			return config, nil
+		},
+	}
+}
+
+// ClientConfig returns the TLS config of a client connecting to the server at the given
+// address and presenting the certificate of the reloader, if any. The server is verified
+// against the CA bundle of the reloader as of each handshake, or against the CAs of the
+// system if it has none, so that both the certificate and the CA bundle are reloaded.
+func (r *Reloader) ClientConfig(addr string) *tls.Config {
+	serverName, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		serverName = addr
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+		// The built-in verification only knows the CA bundle the config was created with,
+		// the server is verified by verifyServer instead.
+		InsecureSkipVerify: true, // #nosec G402
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return r.verifyServer(serverName, rawCerts)
+		},
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			r.lock.RLock()
+			defer r.lock.RUnlock()
+			if r.cert == nil {
+				// An empty certificate lets the server decide whether one is required.
+				return &tls.Certificate{}, nil
+			}
+			return r.cert, nil
+		},
+	}
+}
+
+// verifyServer verifies the certificate chain presented by the server with the given name
+// against the current CA bundle of the reloader.
+func (r *Reloader) verifyServer(serverName string, rawCerts [][]byte) error {
+	if len(rawCerts) == 0 {
+		return errors.New("no server certificate")
+	}
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("could not parse server certificate: %v", err)
+		}
+		certs[i] = cert
+	}
+	opts := x509.VerifyOptions{
+		DNSName:       serverName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	r.lock.RLock()
+	opts.Roots = r.caPool
+	r.lock.RUnlock()
+	_, err := certs[0].Verify(opts)
+	return err
+}
+
+// modified tells whether one of the files was modified since the credentials were loaded.
+func (r *Reloader) modified() bool {
+	modTimes, err := r.fileModTimes()
+	if err != nil {
+		log.Errorf("Could not check TLS credential files: %v", err)
+		return false
+	}
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	for file, modTime := range modTimes {
+		if !modTime.Equal(r.modTimes[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reloader) fileModTimes() (map[string]time.Time, error) {
+	modTimes := make(map[string]time.Time)
+	for _, file := range []string{r.certFile, r.keyFile, r.caFile} {
+		if file == "" {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, fmt.Errorf("could not stat %s: %v", file, err)
+		}
+		modTimes[file] = info.ModTime()
+	}
+	return modTimes, nil
+}
+
+// logClientIdentity logs the identity of a client whose certificate was verified.
+func logClientIdentity(_ [][]byte, verifiedChains [][]*x509.Certificate) error {
+	if len(verifiedChains) == 0 || len(verifiedChains[0]) == 0 {
+		return errors.New("no verified client certificate")
+	}
+	cert := verifiedChains[0][0]
+	log.WithFields(logrus.Fields{
+		"subject": cert.Subject.String(),
+		"issuer":  cert.Issuer.String(),
+		"serial":  cert.SerialNumber.String(),
+	}).Info("Authorized RPC client")
+	return nil
+}
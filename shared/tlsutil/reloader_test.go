@@ -0,0 +1,280 @@
+package tlsutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns the PEM encoded certificate and key of a leaf certificate issued by the CA.
+func (ca *testCA) issue(t *testing.T, name string, serial int64, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func writeFile(t *testing.T, dir string, name string, data []byte) string {
+	file := path.Join(dir, name)
+	if err := ioutil.WriteFile(file, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+// handshake runs a TLS handshake between the configs over a loopback connection, and
+// returns the certificate presented by the server.
+func handshake(serverConfig *tls.Config, clientConfig *tls.Config) (*x509.Certificate, error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	defer lis.Close()
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		server := tls.Server(conn, serverConfig)
+		serverErr <- server.Handshake()
+		server.Close()
+	}()
+	client, err := tls.Dial("tcp", lis.Addr().String(), clientConfig)
+	if err != nil {
+		<-serverErr
+		return nil, err
+	}
+	defer client.Close()
+	if err := <-serverErr; err != nil {
+		return nil, err
+	}
+	return client.ConnectionState().PeerCertificates[0], nil
+}
+
+func TestReloader_MutualTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tlsutil")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	serverCA := newTestCA(t, "server-ca")
+	clientCA := newTestCA(t, "client-ca")
+	serverCert, serverKey := serverCA.issue(t, "localhost", 2, x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := clientCA.issue(t, "validator", 3, x509.ExtKeyUsageClientAuth)
+	otherCert, otherKey := serverCA.issue(t, "intruder", 4, x509.ExtKeyUsageClientAuth)
+
+	server, err := NewReloader(
+		writeFile(t, dir, "server.crt", serverCert),
+		writeFile(t, dir, "server.key", serverKey),
+		writeFile(t, dir, "client-ca.crt", clientCA.pem),
+	)
+	if err != nil {
+		t.Fatalf("Could not load server credentials: %v", err)
+	}
+	serverCAFile := writeFile(t, dir, "server-ca.crt", serverCA.pem)
+	client, err := NewReloader(
+		writeFile(t, dir, "client.crt", clientCert),
+		writeFile(t, dir, "client.key", clientKey),
+		serverCAFile,
+	)
+	if err != nil {
+		t.Fatalf("Could not load client credentials: %v", err)
+	}
+	clientConfig := client.ClientConfig("localhost:4000")
+	if _, err := handshake(server.ServerConfig(), clientConfig); err != nil {
+		t.Errorf("Expected an authorized client to connect, received %v", err)
+	}
+
+	anonymous, err := NewReloader("", "", serverCAFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	anonymousConfig := anonymous.ClientConfig("localhost:4000")
+	if _, err := handshake(server.ServerConfig(), anonymousConfig); err == nil {
+		t.Error("Expected a client without certificate to be rejected")
+	}
+
+	intruder, err := NewReloader(
+		writeFile(t, dir, "intruder.crt", otherCert),
+		writeFile(t, dir, "intruder.key", otherKey),
+		serverCAFile,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intruderConfig := intruder.ClientConfig("localhost:4000")
+	if _, err := handshake(server.ServerConfig(), intruderConfig); err == nil {
+		t.Error("Expected a client with a certificate of another CA to be rejected")
+	}
+}
+
+func TestReloader_ReloadsModifiedFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tlsutil")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ca := newTestCA(t, "ca")
+	cert, key := ca.issue(t, "localhost", 2, x509.ExtKeyUsageServerAuth)
+	certFile := writeFile(t, dir, "server.crt", cert)
+	keyFile := writeFile(t, dir, "server.key", key)
+	server, err := NewReloader(certFile, keyFile, "")
+	if err != nil {
+		t.Fatalf("Could not load server credentials: %v", err)
+	}
+	if server.modified() {
+		t.Error("Expected the files to be unmodified after loading them")
+	}
+
+	client, err := NewReloader("", "", writeFile(t, dir, "ca.crt", ca.pem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientConfig := client.ClientConfig("localhost:4000")
+	serverConfig := server.ServerConfig()
+
+	newCert, newKey := ca.issue(t, "localhost", 3, x509.ExtKeyUsageServerAuth)
+	writeFile(t, dir, "server.crt", newCert)
+	writeFile(t, dir, "server.key", newKey)
+	later := time.Now().Add(time.Minute)
+	for _, file := range []string{certFile, keyFile} {
+		if err := os.Chtimes(file, later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !server.modified() {
+		t.Fatal("Expected the files to be modified")
+	}
+
+	writeFile(t, dir, "server.key", []byte("not a key"))
+	if err := server.Reload(); err == nil {
+		t.Error("Expected reloading an invalid key to fail")
+	}
+	presented, err := handshake(serverConfig, clientConfig)
+	if err != nil {
+		t.Fatalf("Could not connect: %v", err)
+	}
+	if presented.SerialNumber.Int64() != 2 {
+		t.Errorf("Expected the previous certificate to be kept, received serial %d", presented.SerialNumber)
+	}
+
+	writeFile(t, dir, "server.key", newKey)
+	if err := server.Reload(); err != nil {
+		t.Fatalf("Could not reload credentials: %v", err)
+	}
+	presented, err = handshake(serverConfig, clientConfig)
+	if err != nil {
+		t.Fatalf("Could not connect: %v", err)
+	}
+	if presented.SerialNumber.Int64() != 3 {
+		t.Errorf("Expected the reloaded certificate, received serial %d", presented.SerialNumber)
+	}
+}
+
+func TestReloader_ReloadsClientCA(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tlsutil")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	oldCA := newTestCA(t, "old-ca")
+	newCA := newTestCA(t, "new-ca")
+	cert, key := newCA.issue(t, "localhost", 2, x509.ExtKeyUsageServerAuth)
+	server, err := NewReloader(writeFile(t, dir, "server.crt", cert), writeFile(t, dir, "server.key", key), "")
+	if err != nil {
+		t.Fatalf("Could not load server credentials: %v", err)
+	}
+	client, err := NewReloader("", "", writeFile(t, dir, "ca.crt", oldCA.pem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientConfig := client.ClientConfig("localhost:4000")
+	if _, err := handshake(server.ServerConfig(), clientConfig); err == nil {
+		t.Fatal("Expected a server certificate of another CA to be rejected")
+	}
+
+	writeFile(t, dir, "ca.crt", newCA.pem)
+	if err := client.Reload(); err != nil {
+		t.Fatalf("Could not reload credentials: %v", err)
+	}
+	if _, err := handshake(server.ServerConfig(), clientConfig); err != nil {
+		t.Errorf("Expected the server to be verified against the reloaded CA bundle, received %v", err)
+	}
+
+	otherName := client.ClientConfig("example.com:4000")
+	if _, err := handshake(server.ServerConfig(), otherName); err == nil {
+		t.Error("Expected a server certificate for another name to be rejected")
+	}
+}
+
+func TestNewReloader_CertificateWithoutKey(t *testing.T) {
+	if _, err := NewReloader("server.crt", "", ""); err == nil {
+		t.Error("Expected a certificate without key to be refused")
+	}
+}
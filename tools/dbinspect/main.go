@@ -0,0 +1,225 @@
+// Beacon chain DB inspection tool
+//
+// Usage: bazel run //tools/dbinspect -- --db $DATADIR/beaconchaindata <command>
+//
+// This tool opens the DB of a stopped beacon node read-only, to dump its blocks, states,
+// validator index and pending attestations as JSON, or to verify its integrity.
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/urfave/cli"
+)
+
+var (
+	dbFlag = cli.StringFlag{
+		Name:  "db",
+		Usage: "Directory of the beacon chain DB, usually beaconchaindata in the node data directory",
+	}
+	rootFlag = cli.StringFlag{
+		Name:  "root",
+		Usage: "Hex encoded root of the block",
+	}
+	slotFlag = cli.Int64Flag{
+		Name:  "slot",
+		Usage: "Slot since genesis of the main chain block",
+		Value: -1,
+	}
+	finalizedFlag = cli.BoolFlag{
+		Name:  "finalized",
+		Usage: "Dump the finalized state instead of the head state",
+	}
+)
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "dbinspect"
+	app.Usage = "inspect and verify the DB of a stopped beacon node"
+	app.Flags = []cli.Flag{dbFlag}
+	app.Commands = []cli.Command{
+		{
+			Name:   "block",
+			Usage:  "Dump a block by root or by main chain slot",
+			Flags:  []cli.Flag{rootFlag, slotFlag},
+			Action: withDB(dumpBlock),
+		},
+		{
+			Name:   "state",
+			Usage:  "Dump the head or finalized state",
+			Flags:  []cli.Flag{finalizedFlag},
+			Action: withDB(dumpState),
+		},
+		{
+			Name:   "validators",
+			Usage:  "Dump the validator index",
+			Action: withDB(dumpValidators),
+		},
+		{
+			Name:   "attestations",
+			Usage:  "Dump the pending attestations",
+			Action: withDB(dumpAttestations),
+		},
+		{
+			Name:   "verify",
+			Usage:  "Check the integrity of the DB and report corrupted entries",
+			Action: withDB(verify),
+		},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// withDB opens the DB given on the command line read-only for the duration of the action.
+func withDB(action func(*cli.Context, *db.BeaconDB) error) func(*cli.Context) error {
+	return func(ctx *cli.Context) error {
+		dir := ctx.GlobalString(dbFlag.Name)
+		if dir == "" {
+			return errors.New("the --db flag is required")
+		}
+		beaconDB, err := db.NewReadOnlyDB(dir)
+		if err != nil {
+			return fmt.Errorf("could not open DB: %v", err)
+		}
+		defer beaconDB.Close()
+		return action(ctx, beaconDB)
+	}
+}
+
+func dumpBlock(ctx *cli.Context, beaconDB *db.BeaconDB) error {
+	if ctx.IsSet(rootFlag.Name) {
+		root, err := hex.DecodeString(strings.TrimPrefix(ctx.String(rootFlag.Name), "0x"))
+		if err != nil || len(root) != 32 {
+			return errors.New("the block root has to be 32 hex encoded bytes")
+		}
+		block, err := beaconDB.Block(bytesutil.ToBytes32(root))
+		if err != nil {
+			return err
+		}
+		if block == nil {
+			return fmt.Errorf("block %#x not found", root)
+		}
+		return printJSON(block)
+	}
+
+	slot := ctx.Int64(slotFlag.Name)
+	if slot < 0 {
+		return errors.New("either --root or --slot is required")
+	}
+	// The genesis block is recorded in the main chain under slot 0.
+	key := uint64(slot)
+	if slot > 0 {
+		key += params.BeaconConfig().GenesisSlot
+	}
+	block, err := beaconDB.BlockBySlot(key)
+	if err != nil {
+		return err
+	}
+	if block == nil {
+		return fmt.Errorf("no main chain block at slot %d", slot)
+	}
+	return printJSON(block)
+}
+
+func dumpState(ctx *cli.Context, beaconDB *db.BeaconDB) error {
+	if ctx.Bool(finalizedFlag.Name) {
+		beaconState, err := beaconDB.FinalizedState()
+		if err != nil {
+			return err
+		}
+		return printJSON(beaconState)
+	}
+	beaconState, err := beaconDB.HeadState(context.Background())
+	if err != nil {
+		return err
+	}
+	if beaconState == nil {
+		return errors.New("no head state")
+	}
+	return printJSON(beaconState)
+}
+
+type validatorRecord struct {
+	Index      uint64 `json:"index"`
+	PubkeyHash string `json:"pubkeyHash"`
+	Pubkey     string `json:"pubkey,omitempty"`
+}
+
+// dumpValidators prints the validator index records by ascending index. The index is keyed
+// by the hash of the public keys, which are resolved from the head state registry.
+func dumpValidators(_ *cli.Context, beaconDB *db.BeaconDB) error {
+	indices, err := beaconDB.ValidatorIndices()
+	if err != nil {
+		return err
+	}
+	pubkeys := make(map[[32]byte][]byte)
+	beaconState, err := beaconDB.HeadState(context.Background())
+	if err != nil {
+		return err
+	}
+	if beaconState != nil {
+		for _, validator := range beaconState.ValidatorRegistry {
+			pubkeys[hashutil.Hash(validator.Pubkey)] = validator.Pubkey
+		}
+	}
+
+	records := make([]*validatorRecord, 0, len(indices))
+	for h, index := range indices {
+		record := &validatorRecord{
+			Index:      index,
+			PubkeyHash: fmt.Sprintf("%#x", h),
+		}
+		if pubkey, ok := pubkeys[h]; ok {
+			record.Pubkey = fmt.Sprintf("%#x", pubkey)
+		}
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Index < records[j].Index })
+	return printJSON(records)
+}
+
+func dumpAttestations(_ *cli.Context, beaconDB *db.BeaconDB) error {
+	attestations, err := beaconDB.Attestations()
+	if err != nil {
+		return err
+	}
+	return printJSON(attestations)
+}
+
+func verify(_ *cli.Context, beaconDB *db.BeaconDB) error {
+	corruptions, err := beaconDB.VerifyIntegrity()
+	if err != nil {
+		return err
+	}
+	for _, c := range corruptions {
+		fmt.Println(c)
+	}
+	if len(corruptions) > 0 {
+		return fmt.Errorf("found %d corrupted entries", len(corruptions))
+	}
+	fmt.Println("No corruption found")
+	return nil
+}
+
+func printJSON(v interface{}) error {
+	enc, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode JSON: %v", err)
+	}
+	fmt.Println(string(enc))
+	return nil
+}
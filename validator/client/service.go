@@ -8,6 +8,7 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/tlsutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
@@ -19,21 +20,25 @@ var log = logrus.WithField("prefix", "validator")
 // ValidatorService represents a service to manage the validator client
 // routine.
 type ValidatorService struct {
-	ctx       context.Context
-	cancel    context.CancelFunc
-	validator Validator
-	conn      *grpc.ClientConn
-	endpoint  string
-	withCert  string
-	key       *keystore.Key
+	ctx        context.Context
+	cancel     context.CancelFunc
+	validator  Validator
+	conn       *grpc.ClientConn
+	endpoint   string
+	withCert   string
+	clientCert string
+	clientKey  string
+	key        *keystore.Key
 }
 
 // Config for the validator service.
 type Config struct {
-	Endpoint     string
-	CertFlag     string
-	KeystorePath string
-	Password     string
+	Endpoint       string
+	CertFlag       string
+	ClientCertFlag string
+	ClientKeyFlag  string
+	KeystorePath   string
+	Password       string
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -47,11 +52,13 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		return nil, fmt.Errorf("could not get private key: %v", err)
 	}
 	return &ValidatorService{
-		ctx:      ctx,
-		cancel:   cancel,
-		endpoint: cfg.Endpoint,
-		withCert: cfg.CertFlag,
-		key:      key,
+		ctx:        ctx,
+		cancel:     cancel,
+		endpoint:   cfg.Endpoint,
+		withCert:   cfg.CertFlag,
+		clientCert: cfg.ClientCertFlag,
+		clientKey:  cfg.ClientKeyFlag,
+		key:        key,
 	}, nil
 }
 
@@ -60,21 +67,9 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 func (v *ValidatorService) Start() {
 	log.WithField("publicKey", fmt.Sprintf("%#x", v.key.PublicKey.Marshal())).Info("Initializing new validator service")
 
-	var dialOpt grpc.DialOption
-	if v.withCert != "" {
-		creds, err := credentials.NewClientTLSFromFile(v.withCert, "")
-		if err != nil {
-			log.Errorf("Could not get valid credentials: %v", err)
-			return
-		}
-		dialOpt = grpc.WithTransportCredentials(creds)
-	} else {
-		dialOpt = grpc.WithInsecure()
-		log.Warn("You are using an insecure gRPC connection! Please provide a certificate and key to use a secure connection.")
-	}
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpt, grpc.WithStatsHandler(&ocgrpc.ClientHandler{}))
+	conn, err := v.dial()
 	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
+		log.Errorf("Could not connect to beacon node: %v", err)
 		return
 	}
 	log.Info("Successfully started gRPC connection")
@@ -89,6 +84,47 @@ func (v *ValidatorService) Start() {
 	go run(v.ctx, v.validator)
 }
 
+// Exit signs a voluntary exit of the validator with its keystore key, and submits it to
+// the beacon node.
+func (v *ValidatorService) Exit() error {
+	defer v.cancel()
+	conn, err := v.dial()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	val := &validator{
+		beaconClient:    pb.NewBeaconServiceClient(conn),
+		validatorClient: pb.NewValidatorServiceClient(conn),
+		key:             v.key,
+	}
+	return val.Exit(v.ctx)
+}
+
+// dial opens the gRPC connection to the beacon node, secured by TLS if a certificate
+// was provided.
+func (v *ValidatorService) dial() (*grpc.ClientConn, error) {
+	var dialOpt grpc.DialOption
+	if v.withCert != "" {
+		// The client certificate is reloaded when its files change, and only required by
+		// beacon nodes which verify their clients.
+		reloader, err := tlsutil.NewReloader(v.clientCert, v.clientKey, v.withCert)
+		if err != nil {
+			return nil, fmt.Errorf("could not get valid credentials: %v", err)
+		}
+		go reloader.Watch(v.ctx)
+		dialOpt = grpc.WithTransportCredentials(credentials.NewTLS(reloader.ClientConfig(v.endpoint)))
+	} else {
+		dialOpt = grpc.WithInsecure()
+		log.Warn("You are using an insecure gRPC connection! Please provide a certificate and key to use a secure connection.")
+	}
+	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpt, grpc.WithStatsHandler(&ocgrpc.ClientHandler{}))
+	if err != nil {
+		return nil, fmt.Errorf("could not dial endpoint: %s, %v", v.endpoint, err)
+	}
+	return conn, nil
+}
+
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
@@ -0,0 +1,66 @@
+package client
+
+// Validator client voluntary exit functions.
+
+import (
+	"context"
+	"fmt"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/forkutils"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// Exit signs a voluntary exit of the validator at the epoch of the canonical head, and
+// submits it to the beacon node which pools it until a proposer includes it in a block.
+func (v *validator) Exit(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "validator.Exit")
+	defer span.End()
+
+	indexResp, err := v.validatorClient.ValidatorIndex(ctx, &pb.ValidatorIndexRequest{
+		PublicKey: v.key.PublicKey.Marshal(),
+	})
+	if err != nil {
+		return fmt.Errorf("could not get validator index: %v", err)
+	}
+	headBlock, err := v.beaconClient.CanonicalHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return fmt.Errorf("could not fetch canonical head: %v", err)
+	}
+	fork, err := v.beaconClient.ForkData(ctx, &ptypes.Empty{})
+	if err != nil {
+		return fmt.Errorf("could not get fork data from beacon node's state: %v", err)
+	}
+
+	// The exit is signed without its signature, as in:
+	// bls_sign(
+	//   privkey=validator.privkey,
+	//   message_hash=hash_tree_root(VoluntaryExit(epoch, validator_index, signature=EMPTY_SIGNATURE)),
+	//   domain=get_domain(fork, exit.epoch, DOMAIN_EXIT),
+	// )
+	exit := &pbp2p.VoluntaryExit{
+		Epoch:          headBlock.Slot / params.BeaconConfig().SlotsPerEpoch,
+		ValidatorIndex: indexResp.Index,
+	}
+	exitRoot, err := hashutil.HashProto(exit)
+	if err != nil {
+		return fmt.Errorf("could not hash exit: %v", err)
+	}
+	domain := forkutils.DomainVersion(fork, exit.Epoch, params.BeaconConfig().DomainExit)
+	exit.Signature = v.key.SecretKey.Sign(exitRoot[:], domain).Marshal()
+
+	res, err := v.beaconClient.SubmitVoluntaryExit(ctx, exit)
+	if err != nil {
+		return fmt.Errorf("could not submit exit: %v", err)
+	}
+	log.WithField("hash", fmt.Sprintf("%#x", res.ExitHash)).Infof(
+		"Submitted exit of validator %d at epoch %d",
+		exit.ValidatorIndex,
+		exit.Epoch-params.BeaconConfig().GenesisEpoch,
+	)
+	return nil
+}
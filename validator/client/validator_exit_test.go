@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/forkutils"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestExit_SignsAndSubmitsExit(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+	epoch := params.BeaconConfig().GenesisEpoch + 3
+	fork := &pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch + 1,
+		PreviousVersion: 1,
+		CurrentVersion:  2,
+	}
+
+	m.validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		gomock.Eq(&pb.ValidatorIndexRequest{PublicKey: validatorKey.PublicKey.Marshal()}),
+	).Return(&pb.ValidatorIndexResponse{Index: 7}, nil /*err*/)
+
+	m.beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{Slot: epoch*params.BeaconConfig().SlotsPerEpoch + 2}, nil /*err*/)
+
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(fork, nil /*err*/)
+
+	var submitted *pbp2p.VoluntaryExit
+	m.beaconClient.EXPECT().SubmitVoluntaryExit(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.VoluntaryExit{}),
+	).Do(func(_ context.Context, exit *pbp2p.VoluntaryExit) {
+		submitted = exit
+	}).Return(&pb.SubmitExitResponse{}, nil /*err*/)
+
+	if err := validator.Exit(context.Background()); err != nil {
+		t.Fatalf("Could not exit: %v", err)
+	}
+	if submitted.ValidatorIndex != 7 || submitted.Epoch != epoch {
+		t.Fatalf("Expected an exit of validator 7 at epoch %d, received %v", epoch, submitted)
+	}
+
+	exitRoot, err := hashutil.HashProto(&pbp2p.VoluntaryExit{Epoch: epoch, ValidatorIndex: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := bls.SignatureFromBytes(submitted.Signature)
+	if err != nil {
+		t.Fatalf("Could not deserialize exit signature: %v", err)
+	}
+	domain := forkutils.DomainVersion(fork, epoch, params.BeaconConfig().DomainExit)
+	if !sig.Verify(exitRoot[:], validatorKey.PublicKey, domain) {
+		t.Error("Expected the exit to be signed with the validator key over the exit domain")
+	}
+}
+
+func TestExit_ValidatorIndexFailure(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	m.validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
+	).Return(nil /*response*/, errors.New("something bad happened"))
+
+	if err := validator.Exit(context.Background()); err == nil || !strings.Contains(err.Error(), "something bad happened") {
+		t.Errorf("Expected the validator index failure to be returned, received %v", err)
+	}
+}
@@ -88,6 +88,24 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64) {
 		return
 	}
 
+	// Fetch pending voluntary exits which are valid for inclusion. The block is still proposed,
+	// without exits, if they cannot be fetched.
+	exitResp, err := v.proposerClient.PendingVoluntaryExits(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.Errorf("Failed to fetch pending exits from the beacon node: %v", err)
+	}
+
+	// Fetch pending slashings which are valid for inclusion. The block is still proposed,
+	// without slashings, if they cannot be fetched.
+	proposerSlashingResp, err := v.proposerClient.PendingProposerSlashings(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.Errorf("Failed to fetch pending proposer slashings from the beacon node: %v", err)
+	}
+	attesterSlashingResp, err := v.proposerClient.PendingAttesterSlashings(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.Errorf("Failed to fetch pending attester slashings from the beacon node: %v", err)
+	}
+
 	// 2. Construct block.
 	block := &pbp2p.BeaconBlock{
 		Slot:             slot,
@@ -96,10 +114,10 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64) {
 		Eth1Data:         eth1DataResp.Eth1Data,
 		Body: &pbp2p.BeaconBlockBody{
 			Attestations:      attResp.PendingAttestations,
-			ProposerSlashings: nil, // TODO(1438): Add after operations pool
-			AttesterSlashings: nil, // TODO(1438): Add after operations pool
+			ProposerSlashings: proposerSlashingResp.GetPendingProposerSlashings(),
+			AttesterSlashings: attesterSlashingResp.GetPendingAttesterSlashings(),
 			Deposits:          pDepResp.PendingDeposits,
-			VoluntaryExits:    nil, // TODO(1323): Add validator exits
+			VoluntaryExits:    exitResp.GetPendingExits(),
 		},
 	}
 
@@ -139,6 +139,66 @@ func (mr *MockBeaconServiceClientMockRecorder) PendingDeposits(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingDeposits", reflect.TypeOf((*MockBeaconServiceClient)(nil).PendingDeposits), varargs...)
 }
 
+// SubmitAttesterSlashing mocks base method
+func (m *MockBeaconServiceClient) SubmitAttesterSlashing(arg0 context.Context, arg1 *v1.AttesterSlashing, arg2 ...grpc.CallOption) (*v10.SubmitSlashingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubmitAttesterSlashing", varargs...)
+	ret0, _ := ret[0].(*v10.SubmitSlashingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitAttesterSlashing indicates an expected call of SubmitAttesterSlashing
+func (mr *MockBeaconServiceClientMockRecorder) SubmitAttesterSlashing(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAttesterSlashing", reflect.TypeOf((*MockBeaconServiceClient)(nil).SubmitAttesterSlashing), varargs...)
+}
+
+// SubmitProposerSlashing mocks base method
+func (m *MockBeaconServiceClient) SubmitProposerSlashing(arg0 context.Context, arg1 *v1.ProposerSlashing, arg2 ...grpc.CallOption) (*v10.SubmitSlashingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubmitProposerSlashing", varargs...)
+	ret0, _ := ret[0].(*v10.SubmitSlashingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitProposerSlashing indicates an expected call of SubmitProposerSlashing
+func (mr *MockBeaconServiceClientMockRecorder) SubmitProposerSlashing(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitProposerSlashing", reflect.TypeOf((*MockBeaconServiceClient)(nil).SubmitProposerSlashing), varargs...)
+}
+
+// SubmitVoluntaryExit mocks base method
+func (m *MockBeaconServiceClient) SubmitVoluntaryExit(arg0 context.Context, arg1 *v1.VoluntaryExit, arg2 ...grpc.CallOption) (*v10.SubmitExitResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubmitVoluntaryExit", varargs...)
+	ret0, _ := ret[0].(*v10.SubmitExitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitVoluntaryExit indicates an expected call of SubmitVoluntaryExit
+func (mr *MockBeaconServiceClientMockRecorder) SubmitVoluntaryExit(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitVoluntaryExit", reflect.TypeOf((*MockBeaconServiceClient)(nil).SubmitVoluntaryExit), varargs...)
+}
+
 // WaitForChainStart mocks base method
 func (m *MockBeaconServiceClient) WaitForChainStart(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (v10.BeaconService_WaitForChainStartClient, error) {
 	m.ctrl.T.Helper()
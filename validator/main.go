@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -8,8 +9,10 @@ import (
 
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/client"
 	"github.com/prysmaticlabs/prysm/validator/node"
 	"github.com/prysmaticlabs/prysm/validator/types"
 	"github.com/sirupsen/logrus"
@@ -49,6 +52,27 @@ func createValidatorAccount(ctx *cli.Context) error {
 	return nil
 }
 
+func exitValidator(ctx *cli.Context) error {
+	if ctx.GlobalBool(types.DemoConfigFlag.Name) {
+		params.UseDemoBeaconConfig()
+	}
+	v, err := client.NewValidatorService(context.Background(), &client.Config{
+		Endpoint:       ctx.GlobalString(types.BeaconRPCProviderFlag.Name),
+		CertFlag:       ctx.GlobalString(types.CertFlag.Name),
+		ClientCertFlag: ctx.GlobalString(types.ClientCertFlag.Name),
+		ClientKeyFlag:  ctx.GlobalString(types.ClientKeyFlag.Name),
+		KeystorePath:   ctx.String(types.KeystorePathFlag.Name),
+		Password:       ctx.String(types.PasswordFlag.Name),
+	})
+	if err != nil {
+		return fmt.Errorf("could not load validator key: %v", err)
+	}
+	if err := v.Exit(); err != nil {
+		return fmt.Errorf("could not exit validator: %v", err)
+	}
+	return nil
+}
+
 func main() {
 	customFormatter := new(prefixed.TextFormatter)
 	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
@@ -80,10 +104,25 @@ contract in order to activate the validator client`,
 				},
 			},
 		},
+		{
+			Name:  "exit",
+			Usage: "signs a voluntary exit of the validator and submits it to the beacon node",
+			Description: `signs a voluntary exit of the validator at the current epoch with its keystore key -
+the beacon node broadcasts the exit, which proposers then include in a block. The validator keeps
+its duties until the exit takes effect`,
+			Flags: []cli.Flag{
+				types.KeystorePathFlag,
+				types.PasswordFlag,
+			},
+			Action: exitValidator,
+		},
 	}
 	app.Flags = []cli.Flag{
 		types.DemoConfigFlag,
 		types.BeaconRPCProviderFlag,
+		types.CertFlag,
+		types.ClientCertFlag,
+		types.ClientKeyFlag,
 		types.KeystorePathFlag,
 		types.PasswordFlag,
 		cmd.VerbosityFlag,
@@ -128,9 +128,12 @@ func (s *ValidatorClient) registerClientService(ctx *cli.Context) error {
 	keystoreDirectory := ctx.GlobalString(types.KeystorePathFlag.Name)
 	keystorePassword := ctx.String(types.PasswordFlag.Name)
 	v, err := client.NewValidatorService(context.Background(), &client.Config{
-		Endpoint:     endpoint,
-		KeystorePath: keystoreDirectory,
-		Password:     keystorePassword,
+		Endpoint:       endpoint,
+		CertFlag:       ctx.GlobalString(types.CertFlag.Name),
+		ClientCertFlag: ctx.GlobalString(types.ClientCertFlag.Name),
+		ClientKeyFlag:  ctx.GlobalString(types.ClientKeyFlag.Name),
+		KeystorePath:   keystoreDirectory,
+		Password:       keystorePassword,
 	})
 	if err != nil {
 		return fmt.Errorf("could not initialize client service: %v", err)
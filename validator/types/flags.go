@@ -17,10 +17,21 @@ var (
 		Usage: "Beacon node RPC provider endpoint",
 		Value: "localhost:4000",
 	}
-	// CertFlag defines a flag for the node's TLS certificate.
+	// CertFlag defines a flag for the certificate verifying the TLS certificate of the beacon node.
 	CertFlag = cli.StringFlag{
 		Name:  "tls-cert",
-		Usage: "Certificate for secure gRPC. Pass this and the tls-key flag in order to use gRPC securely.",
+		Usage: "Certificate or CA bundle verifying the beacon node certificate, in order to use gRPC securely.",
+	}
+	// ClientCertFlag defines a flag for the certificate presented to beacon nodes which
+	// verify the certificates of their clients.
+	ClientCertFlag = cli.StringFlag{
+		Name:  "tls-client-cert",
+		Usage: "Certificate presented to beacon nodes which require client certificates. Pass this and the tls-client-key flag together.",
+	}
+	// ClientKeyFlag defines a flag for the key of the client certificate.
+	ClientKeyFlag = cli.StringFlag{
+		Name:  "tls-client-key",
+		Usage: "Key of the certificate presented to beacon nodes which require client certificates",
 	}
 	// KeystorePathFlag defines the location of the keystore directory for a validator's account.
 	KeystorePathFlag = cli.StringFlag{
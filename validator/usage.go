@@ -68,6 +68,9 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			types.DemoConfigFlag,
 			types.BeaconRPCProviderFlag,
+			types.CertFlag,
+			types.ClientCertFlag,
+			types.ClientKeyFlag,
 			types.KeystorePathFlag,
 			types.PasswordFlag,
 		},